@@ -7,7 +7,10 @@ import (
 	"os"
 	"path/filepath"
 
+	"github.com/ebladrocher/keypass/crypto"
+	"github.com/ebladrocher/keypass/crypto/age"
 	"github.com/ebladrocher/keypass/crypto/gpg"
+	"github.com/ebladrocher/keypass/crypto/openpgp"
 	"github.com/ebladrocher/keypass/fsutil"
 	"github.com/ebladrocher/keypass/storepass"
 	"github.com/fatih/color"
@@ -24,6 +27,8 @@ type Action struct {
 func New() *Action {
 	if gdb := os.Getenv("KEYPASS_DEBUG"); gdb == "true" {
 		gpg.Debug = true
+		age.Debug = true
+		openpgp.Debug = true
 	}
 	if nc := os.Getenv("KEYPASS_NOCOLOR"); nc == "true" {
 		color.NoColor = true
@@ -35,15 +40,22 @@ func New() *Action {
 
 	pwDir := pwStoreDir("")
 
-	if cfg, err := newFromFile(configFile()); err == nil && cfg != nil {
+	c := gpg.New()
+	a := age.New(os.Getenv("KEYPASS_AGE_IDENTITIES"))
+	a.PassphraseFunc = promptPass
+	o := openpgp.New(os.Getenv("KEYPASS_OPENPGP_PUBRING"), filepath.SplitList(os.Getenv("KEYPASS_OPENPGP_KEYRING"))...)
+	o.PassphraseFunc = promptPass
+
+	if cfg, err := newFromFile(configFile(), c, a, o); err == nil && cfg != nil {
 		cfg.ImportFunc = askForKeyImport
+		cfg.FsckFunc = askForConfirmation
 		return &Action{
 			Name:  name,
 			Store: cfg,
 		}
 	}
 
-	cfg, err := storepass.NewRootStore(pwDir)
+	cfg, err := storepass.NewRootStore(pwDir, c, a, o)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -57,7 +69,7 @@ func New() *Action {
 	}
 }
 
-func newFromFile(cf string) (*storepass.RootStore, error) {
+func newFromFile(cf string, c crypto.Crypto, backends ...crypto.Crypto) (*storepass.RootStore, error) {
 	if _, err := os.Stat(cf); err != nil {
 		return nil, err
 	}
@@ -66,7 +78,7 @@ func newFromFile(cf string) (*storepass.RootStore, error) {
 		fmt.Printf("Ошибка чтения конфига из %s: %s\n", cf, err)
 		return nil, err
 	}
-	cfg := &storepass.RootStore{}
+	cfg := &storepass.RootStore{Crypto: c, Backends: backends}
 	err = yaml.Unmarshal(buf, &cfg)
 	if err != nil {
 		fmt.Printf("Ошибка чтения конфига из %s: %s\n", cf, err)
@@ -0,0 +1,101 @@
+package action
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ebladrocher/keypass/audit"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Audit ...
+func (s *Action) Audit(c *cli.Context) error {
+	o := audit.Options{
+		Prefix:   c.Args().First(),
+		Jobs:     c.Int("jobs"),
+		MaxAge:   time.Duration(c.Int("max-age")) * 24 * time.Hour,
+		MinScore: c.Int("min-score"),
+	}
+
+	if fn := c.String("hibp-file"); fn != "" {
+		h, err := audit.OpenHIBP(fn)
+		if err != nil {
+			return fmt.Errorf("не удалось открыть список HIBP: %s", err)
+		}
+		defer func() {
+			_ = h.Close()
+		}()
+		o.Breaches = h
+	}
+
+	r, err := audit.Run(c.Context, s.Store, o)
+	if err != nil {
+		return err
+	}
+
+	if c.Bool("json") {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	} else {
+		printAudit(r, c.Int("max-age"))
+	}
+
+	if n := r.Problems(); n > 0 {
+		return fmt.Errorf("найдено проблем: %d", n)
+	}
+	return nil
+}
+
+func printAudit(r *audit.Report, maxAge int) {
+	fmt.Printf("Проверено секретов: %d\n", r.Checked)
+
+	if len(r.Weak) > 0 {
+		fmt.Println(color.RedString("Слабые пароли:"))
+		for _, w := range r.Weak {
+			fmt.Printf("  %s (оценка %d/4, %.0f бит)", color.YellowString(w.Name), w.Score, w.Bits)
+			if w.Warning != "" {
+				fmt.Printf(": %s", w.Warning)
+			}
+			fmt.Println()
+		}
+	}
+
+	if len(r.Duplicates) > 0 {
+		fmt.Println(color.RedString("Повторяющиеся пароли:"))
+		for _, names := range r.Duplicates {
+			fmt.Printf("  %s\n", color.YellowString(strings.Join(names, ", ")))
+		}
+	}
+
+	if len(r.Old) > 0 {
+		fmt.Println(color.RedString("Пароли старше %d дней:", maxAge))
+		for _, o := range r.Old {
+			fmt.Printf("  %s (изменен %s, %d дней назад)\n", color.YellowString(o.Name), o.Modified.Format("2006-01-02"), o.Days)
+		}
+	}
+
+	if len(r.Breached) > 0 {
+		fmt.Println(color.RedString("Пароли из известных утечек:"))
+		for _, b := range r.Breached {
+			fmt.Printf("  %s (встречается %d раз)\n", color.YellowString(b.Name), b.Count)
+		}
+	}
+
+	if len(r.Errors) > 0 {
+		fmt.Println(color.RedString("Ошибки:"))
+		for _, e := range r.Errors {
+			fmt.Printf("  %s: %s\n", color.YellowString(e.Name), e.Error)
+		}
+	}
+
+	if r.Problems() == 0 {
+		fmt.Println(color.GreenString("Проблем не найдено"))
+	}
+}
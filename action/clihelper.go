@@ -3,13 +3,14 @@ package action
 import (
 	"bufio"
 	"fmt"
+	"io"
 	"os"
 	"os/signal"
 	"sort"
 	"strconv"
 	"strings"
 
-	"github.com/ebladrocher/keypass/crypto/gpg"
+	"github.com/ebladrocher/keypass/crypto"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -21,7 +22,7 @@ func (s *Action) confirmRecipients(name string, recipients []string) ([]string,
 		fmt.Printf("keypass: Шифрование %s для этих получателей:\n", name)
 		sort.Strings(recipients)
 		for _, r := range recipients {
-			kl, err := gpg.ListPublicKeys(r)
+			kl, err := s.Store.CryptoFor(name).ListPublicKeys(r)
 			if err != nil {
 				fmt.Println(err)
 				continue
@@ -47,11 +48,17 @@ func (s *Action) confirmRecipients(name string, recipients []string) ([]string,
 	}
 }
 
+var stdin = bufio.NewReader(os.Stdin)
+
 func askForConfirmation(text string) bool {
 	for {
-		if choice, err := askForBool(text, false); err == nil {
+		choice, err := askForBool(text, false)
+		if err == nil {
 			return choice
 		}
+		if err == io.EOF {
+			return false
+		}
 	}
 }
 
@@ -104,11 +111,9 @@ func askForInt(text string, def int) (int, error) {
 }
 
 func askForString(text, def string) (string, error) {
-	reader := bufio.NewReader(os.Stdin)
-
 	fmt.Printf("%s [%s]: ", text, def)
-	input, err := reader.ReadString('\n')
-	if err != nil {
+	input, err := stdin.ReadString('\n')
+	if err != nil && (err != io.EOF || input == "") {
 		return "", err
 	}
 	input = strings.TrimSpace(input)
@@ -141,8 +146,8 @@ func askForPassword(name string, askFn func(string) (string, error)) (string, er
 	}
 }
 
-func askForPrivateKey(prompt string) (string, error) {
-	kl, err := gpg.ListPrivateKeys()
+func askForPrivateKey(c crypto.Crypto, prompt string) (string, error) {
+	kl, err := c.ListPrivateKeys()
 	if err != nil {
 		return "", err
 	}
@@ -156,6 +161,9 @@ func askForPrivateKey(prompt string) (string, error) {
 			fmt.Printf("[%d] %s\n", i, k.OneLine())
 		}
 		iv, err := askForInt(fmt.Sprintf("Пожалуйста, введите номер ключа (0-%d)", len(kl)-1), 0)
+		if err == io.EOF {
+			return "", err
+		}
 		if err != nil {
 			continue
 		}
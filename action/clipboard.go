@@ -7,11 +7,35 @@ import (
 	"os/exec"
 	"strconv"
 	"syscall"
+	"time"
+
+	"github.com/atotto/clipboard"
+	"github.com/urfave/cli/v2"
 )
 
+// clipboardProvider ...
+type clipboardProvider interface {
+	ReadAll() (string, error)
+	WriteAll(text string) error
+}
+
+type systemClipboard struct{}
+
+func (systemClipboard) ReadAll() (string, error) {
+	return clipboard.ReadAll()
+}
+
+func (systemClipboard) WriteAll(text string) error {
+	return clipboard.WriteAll(text)
+}
+
+// clip is the clipboard used by all commands, it can be replaced by a
+// fake provider
+var clip clipboardProvider = systemClipboard{}
+
 // clearClipboard ...
 func clearClipboard(content []byte, timeout int) error {
-	hash := fmt.Sprintf("%x", sha256.Sum256(content))
+	hash := checksum(content)
 
 	cmd := exec.Command(os.Args[0], "unclip", "--timeout", strconv.Itoa(timeout))
 	// https://groups.google.com/d/msg/golang-nuts/shST-SDqIp4/za4oxEiVtI0J
@@ -21,3 +45,37 @@ func clearClipboard(content []byte, timeout int) error {
 	cmd.Env = append(os.Environ(), "KEYPASS_UNCLIP_CHECKSUM="+hash)
 	return cmd.Start()
 }
+
+// Unclip ...
+func (s *Action) Unclip(c *cli.Context) error {
+	sum := os.Getenv("KEYPASS_UNCLIP_CHECKSUM")
+	if sum == "" {
+		return fmt.Errorf("не задана переменная KEYPASS_UNCLIP_CHECKSUM")
+	}
+
+	time.Sleep(time.Duration(c.Int("timeout")) * time.Second)
+
+	return unclip(clip, sum)
+}
+
+// unclip clears the clipboard only if it still holds the content with the
+// given checksum, so anything copied in the meantime survives
+func unclip(cb clipboardProvider, sum string) error {
+	cur, err := cb.ReadAll()
+	if err != nil {
+		return fmt.Errorf("не удалось прочитать буфер обмена: %s", err)
+	}
+
+	if checksum([]byte(cur)) != sum {
+		return nil
+	}
+
+	if err := cb.WriteAll(""); err != nil {
+		return fmt.Errorf("не удалось очистить буфер обмена: %s", err)
+	}
+	return nil
+}
+
+func checksum(content []byte) string {
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
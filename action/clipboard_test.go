@@ -0,0 +1,67 @@
+package action
+
+import (
+	"fmt"
+	"testing"
+)
+
+type fakeClipboard struct {
+	content string
+	writes  int
+	err     error
+}
+
+func (f *fakeClipboard) ReadAll() (string, error) {
+	return f.content, f.err
+}
+
+func (f *fakeClipboard) WriteAll(text string) error {
+	f.writes++
+	f.content = text
+	return nil
+}
+
+func TestUnclip(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		content string
+		copied  string
+		want    string
+	}{
+		{
+			name:    "unchanged",
+			content: "secret",
+			copied:  "secret",
+			want:    "",
+		},
+		{
+			name:    "changed",
+			content: "something else",
+			copied:  "secret",
+			want:    "something else",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := &fakeClipboard{content: tc.content}
+			if err := unclip(cb, checksum([]byte(tc.copied))); err != nil {
+				t.Fatalf("unclip: %s", err)
+			}
+			if cb.content != tc.want {
+				t.Errorf("clipboard = %q, want %q", cb.content, tc.want)
+			}
+			if tc.want == tc.content && cb.writes > 0 {
+				t.Errorf("clipboard written %d times, want none", cb.writes)
+			}
+		})
+	}
+}
+
+func TestUnclipReadError(t *testing.T) {
+	cb := &fakeClipboard{content: "secret", err: fmt.Errorf("no clipboard")}
+	if err := unclip(cb, checksum([]byte("secret"))); err == nil {
+		t.Error("unclip succeeded without a readable clipboard")
+	}
+	if cb.writes > 0 {
+		t.Errorf("clipboard written %d times, want none", cb.writes)
+	}
+}
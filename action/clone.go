@@ -2,10 +2,9 @@ package action
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 
 	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/storepass"
 	"github.com/urfave/cli/v2"
 )
 
@@ -30,7 +29,7 @@ func (s *Action) Clone(c *cli.Context) error {
 		return fmt.Errorf("Невозможно клонировать %s в корневое хранилище, так как это хранилище уже инициализировано.  Попробуйте клонировать submount: `keypass clone %s sub`", repo, repo)
 	}
 
-	if err := gitClone(repo, path); err != nil {
+	if err := s.gitClone(repo, path); err != nil {
 		return err
 	}
 
@@ -39,7 +38,7 @@ func (s *Action) Clone(c *cli.Context) error {
 			return fmt.Errorf("Root-Store не инициализирован. Сначала клонируйте или инициализируйте корневое хранилище")
 		}
 		fmt.Printf("Монтирование хранилища паролей %s в точке монтирования `%s` ...\n", path, mount)
-		if err := s.Store.AddMount(mount, path); err != nil {
+		if err := s.Store.AddMount(mount, path, ""); err != nil {
 			return err
 		}
 	}
@@ -48,26 +47,21 @@ func (s *Action) Clone(c *cli.Context) error {
 		return err
 	}
 
+	if err := s.Store.GitConfigure(mount); err != nil {
+		fmt.Printf("Не удалось настроить git: %s\n", err)
+	}
+
 	fmt.Printf("Ваше хранилище паролей готово к использованию! Посмотрите: `keypass %s`\n", mount)
 
 	return nil
 }
 
-func gitClone(repo, path string) error {
+func (s *Action) gitClone(repo, path string) error {
 	if fsutil.IsDir(path) {
 		return fmt.Errorf("%s это дирректория", path)
 	}
 
 	fmt.Printf("клонирование репозитория %s в %s ...\n", repo, path)
 
-	cmd := exec.Command("git", "clone", repo, path)
-	cmd.Stdout = os.Stdout
-	cmd.Stdin = os.Stdin
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
-		return err
-	}
-
-	return nil
+	return storepass.GitClone(s.Store.GitBackend, repo, path)
 }
@@ -17,7 +17,8 @@ func (s *Action) GetCommands() []*cli.Command {
 			Usage: "Сгенерируйте новый пароль",
 			Description: "" +
 				"Сгенерировать новый пароль указанной длины" +
-				"При желании поместите его в буфер обмена и очистите доску через 45 секунд",
+				"При желании поместите его в буфер обмена и очистите доску через 45 секунд. " +
+				"Правила генерации берутся из ближайшего файла .keypass-policy и флагов политики.",
 			Before: s.Initialized,
 			Action: s.Generate,
 			Flags: []cli.Flag{
@@ -31,11 +32,81 @@ func (s *Action) GetCommands() []*cli.Command {
 					Aliases: []string{"f"},
 					Usage:   "Принудительно перезаписать существующий пароль",
 				},
+				&cli.BoolFlag{
+					Name:  "overwrite",
+					Usage: "Перезаписать весь секрет, а не только пароль в первой строке",
+				},
+				&cli.BoolFlag{
+					Name:  "history",
+					Usage: "Сохранить старый пароль в разделе previous-passwords",
+				},
 				&cli.BoolFlag{
 					Name:    "no-symbols",
 					Aliases: []string{"n"},
 					Usage:   "Не использовать символы в пароле ",
 				},
+				&cli.BoolFlag{
+					Name:    "xkcd",
+					Aliases: []string{"x"},
+					Usage:   "Сгенерировать парольную фразу из слов, длина задает количество слов",
+				},
+				&cli.StringFlag{
+					Name:  "xkcd-sep",
+					Usage: "Разделитель слов парольной фразы",
+					Value: " ",
+				},
+				&cli.BoolFlag{
+					Name:  "xkcd-capitalize",
+					Usage: "Начинать каждое слово с заглавной буквы",
+				},
+				&cli.BoolFlag{
+					Name:  "xkcd-digit",
+					Usage: "Добавить случайную цифру к одному из слов",
+				},
+				&cli.BoolFlag{
+					Name:  "pronounceable",
+					Usage: "Сгенерировать произносимый пароль из слогов",
+				},
+				&cli.BoolFlag{
+					Name:  "pin",
+					Usage: "Сгенерировать PIN-код без повторяющихся и последовательных цифр",
+				},
+				&cli.IntFlag{
+					Name:  "min-length",
+					Usage: "Минимальная длина пароля",
+				},
+				&cli.IntFlag{
+					Name:  "max-length",
+					Usage: "Максимальная длина пароля",
+				},
+				&cli.IntFlag{
+					Name:  "min-upper",
+					Usage: "Минимальное количество заглавных букв",
+				},
+				&cli.IntFlag{
+					Name:  "min-lower",
+					Usage: "Минимальное количество строчных букв",
+				},
+				&cli.IntFlag{
+					Name:  "min-digits",
+					Usage: "Минимальное количество цифр",
+				},
+				&cli.IntFlag{
+					Name:  "min-symbols",
+					Usage: "Минимальное количество символов",
+				},
+				&cli.StringFlag{
+					Name:  "allowed",
+					Usage: "Использовать только эти символы",
+				},
+				&cli.StringFlag{
+					Name:  "forbidden",
+					Usage: "Никогда не использовать эти символы",
+				},
+				&cli.IntFlag{
+					Name:  "max-repeat",
+					Usage: "Максимальное количество одинаковых символов подряд",
+				},
 			},
 		},
 		{
@@ -43,15 +114,66 @@ func (s *Action) GetCommands() []*cli.Command {
 			Usage: "Показать существующий секрет и при желании поместить его в буфер обмена.",
 			Description: "" +
 				"Показать существующий секрет и при желании поместить его в буфер обмена. " +
-				"Если поместить в буфер обмена, он будет очищен за 45 секунд.",
-			Before: s.Initialized,
-			Action: s.Show,
+				"Если поместить в буфер обмена, он будет очищен за 45 секунд. " +
+				"Если указан ключ, показывается только значение поля `ключ: значение`.",
+			ArgsUsage: "<name> [key]",
+			Before:    s.Initialized,
+			Action:    s.Show,
 			Flags: []cli.Flag{
 				&cli.BoolFlag{
 					Name:    "clip",
 					Aliases: []string{"c"},
 					Usage:   "Скопируйте пароль в буфер обмена",
 				},
+				&cli.StringFlag{
+					Name:    "revision",
+					Aliases: []string{"r"},
+					Usage:   "Показать секрет из указанной ревизии git",
+				},
+			},
+		},
+		{
+			Name:        "history",
+			Usage:       "Показать историю изменений секрета",
+			Description: "Эта команда выводит коммиты git, изменявшие секрет: дату, автора и сообщение.",
+			ArgsUsage:   "<name>",
+			Before:      s.Initialized,
+			Action:      s.History,
+		},
+		{
+			Name:  "diff",
+			Usage: "Показать изменения секрета между ревизиями",
+			Description: "" +
+				"Эта команда показывает построчную разницу расшифрованного секрета между двумя ревизиями git. " +
+				"Без ревизий сравнивается предыдущая версия с текущей, с одной ревизией - она с текущей. " +
+				"Пароль в первой строке скрыт.",
+			ArgsUsage: "<name> [rev1] [rev2]",
+			Before:    s.Initialized,
+			Action:    s.Diff,
+		},
+		{
+			Name:        "revert",
+			Usage:       "Восстановить секрет из ревизии git",
+			Description: "Эта команда восстанавливает секрет из указанной ревизии и шифрует его для текущих получателей.",
+			ArgsUsage:   "<name> <revision>",
+			Before:      s.Initialized,
+			Action:      s.Revert,
+		},
+		{
+			Name:  "otp",
+			Usage: "Показать одноразовый пароль (TOTP/HOTP)",
+			Description: "" +
+				"Вычислить текущий одноразовый код по otpauth:// URI или полю `totp:` секрета. " +
+				"Для TOTP показывает, сколько секунд код еще действителен.",
+			ArgsUsage: "<name>",
+			Before:    s.Initialized,
+			Action:    s.OTP,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "clip",
+					Aliases: []string{"c"},
+					Usage:   "Скопировать код в буфер обмена",
+				},
 			},
 		},
 		{
@@ -70,6 +192,19 @@ func (s *Action) GetCommands() []*cli.Command {
 					Name:  "nogit",
 					Usage: "Не инициализировать git репозиторий",
 				},
+				&cli.StringFlag{
+					Name:  "crypto",
+					Usage: "Криптографический бэкенд: 'gpg', 'openpgp' или 'age'",
+				},
+				&cli.StringFlag{
+					Name:    "path",
+					Aliases: []string{"p"},
+					Usage:   "Инициализировать отдельных получателей для подпапки",
+				},
+				&cli.StringFlag{
+					Name:  "import-key",
+					Usage: "Импортировать экспортированный закрытый ключ (только openpgp)",
+				},
 			},
 		},
 		{
@@ -102,7 +237,8 @@ func (s *Action) GetCommands() []*cli.Command {
 			Name:  "delete",
 			Usage: "Удалите существующий секрет",
 			Description: "" +
-				"Эта команда удаляет секреты. Она может рекурсивно работать с папками. ",
+				"Эта команда удаляет секреты. Она может рекурсивно работать с папками. " +
+				"Если включена корзина (keypass config trash true), секреты перемещаются в .trash/ и их можно восстановить.",
 			Aliases: []string{"rm"},
 			Before:  s.Initialized,
 			Action:  s.Delete,
@@ -117,6 +253,61 @@ func (s *Action) GetCommands() []*cli.Command {
 					Aliases: []string{"f"},
 					Usage:   "Принудительно удалить секрет",
 				},
+				&cli.BoolFlag{
+					Name:  "permanent",
+					Usage: "Удалить секрет безвозвратно, минуя корзину",
+				},
+			},
+		},
+		{
+			Name:  "trash",
+			Usage: "Управление удаленными секретами",
+			Description: "" +
+				"Удаленные секреты хранятся в скрытой папке .trash/ каждого хранилища вместе со временем удаления.",
+			Subcommands: []*cli.Command{
+				{
+					Name:    "list",
+					Aliases: []string{"ls"},
+					Usage:   "Показать секреты в корзине",
+					Before:  s.Initialized,
+					Action:  s.TrashList,
+				},
+				{
+					Name:        "restore",
+					Usage:       "Восстановить секрет или папку из корзины",
+					Description: "Восстанавливает последнюю удаленную версию секрета или папки, либо версию из корзины с указанным --id.",
+					ArgsUsage:   "<name>",
+					Before:      s.Initialized,
+					Action:      s.TrashRestore,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "id",
+							Usage: "Папка корзины, как ее показывает 'trash list'",
+						},
+						&cli.BoolFlag{
+							Name:    "force",
+							Aliases: []string{"f"},
+							Usage:   "Перезаписать существующие секреты",
+						},
+					},
+				},
+				{
+					Name:   "empty",
+					Usage:  "Безвозвратно удалить секреты из корзины",
+					Before: s.Initialized,
+					Action: s.TrashEmpty,
+					Flags: []cli.Flag{
+						&cli.IntFlag{
+							Name:  "older-than",
+							Usage: "Удалить только секреты, удаленные более указанного числа дней назад",
+						},
+						&cli.BoolFlag{
+							Name:    "force",
+							Aliases: []string{"f"},
+							Usage:   "Не запрашивать подтверждение",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -165,6 +356,22 @@ func (s *Action) GetCommands() []*cli.Command {
 						},
 					},
 				},
+				{
+					Name:        "merge-driver",
+					Usage:       "Драйвер слияния git для зашифрованных секретов",
+					Description: "Расшифровывает базовую, нашу и их версии, объединяет их построчно и шифрует результат для текущих получателей.",
+					ArgsUsage:   "%O %A %B [%P]",
+					Hidden:      true,
+					Action:      s.GitMergeDriver,
+				},
+				{
+					Name:        "textconv",
+					Usage:       "Расшифровать файл для git diff",
+					Description: "Выводит расшифрованное содержимое файла, используется git как diff.gpg.textconv.",
+					ArgsUsage:   "<file>",
+					Hidden:      true,
+					Action:      s.GitTextConv,
+				},
 			},
 		},
 		{
@@ -179,21 +386,49 @@ func (s *Action) GetCommands() []*cli.Command {
 			Before: s.Initialized,
 			Action: s.Grep,
 		},
+		{
+			Name:    "copy",
+			Aliases: []string{"cp"},
+			Usage:   "Скопировать секреты из одного места в другое.",
+			Description: "" +
+				"Эта команда копирует секрет или папку с одного пути на другой, в том числе между точками монтирования. " +
+				"Секреты шифруются для получателей места назначения.",
+			Before: s.Initialized,
+			Action: s.Copy,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "force",
+					Aliases: []string{"f"},
+					Usage:   "Принудительно скопировать секрет и перезаписать существующий",
+				},
+			},
+		},
 		{
 			Name:    "move",
 			Aliases: []string{"mv"},
 			Usage:   "Переместить секреты из одного места в другое.",
 			Description: "" +
-				"Эта команда перемещает секрет с одного пути на другой",
+				"Эта команда перемещает секрет или папку с одного пути на другой, в том числе между точками монтирования. " +
+				"Секреты шифруются для получателей места назначения.",
 			Before: s.Initialized,
 			Action: s.Move,
 			Flags: []cli.Flag{
 				&cli.BoolFlag{
-					Name:  "force, f",
-					Usage: "Принудительно переместить секрет и перезаписать существующий",
+					Name:    "force",
+					Aliases: []string{"f"},
+					Usage:   "Принудительно переместить секрет и перезаписать существующий",
 				},
 			},
 		},
+		{
+			Name:  "sync",
+			Usage: "Синхронизировать все хранилища с удаленными репозиториями",
+			Description: "" +
+				"Для корневого хранилища и каждой точки монтирования выполняет git pull --rebase и git push, " +
+				"импортирует ключи новых получателей и показывает новые, измененные и удаленные секреты.",
+			Before: s.Initialized,
+			Action: s.Sync,
+		},
 		{
 			Name:  "clone",
 			Usage: "Клонировать магазин из git",
@@ -211,6 +446,170 @@ func (s *Action) GetCommands() []*cli.Command {
 				},
 			},
 		},
+		{
+			Name:        "mount",
+			Usage:       "Управление точками монтирования хранилищ",
+			Description: "Эта команда позволяет подключать и отключать дополнительные хранилища паролей.",
+			Before:      s.Initialized,
+			Action:      s.MountsPrint,
+			Subcommands: []*cli.Command{
+				{
+					Name:        "add",
+					Usage:       "Примонтировать хранилище паролей",
+					Description: "Примонтировать хранилище паролей по пути <path> в точке <alias>. Если хранилище не инициализировано, оно будет инициализировано для указанных ключей.",
+					ArgsUsage:   "<alias> <path> [keys...]",
+					Before:      s.Initialized,
+					Action:      s.MountAdd,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "crypto",
+							Usage: "Криптографический бэкенд нового хранилища: 'gpg', 'openpgp' или 'age'",
+						},
+					},
+				},
+				{
+					Name:        "remove",
+					Aliases:     []string{"rm"},
+					Usage:       "Отмонтировать хранилище паролей",
+					Description: "Удалить точку монтирования. Файлы хранилища не удаляются.",
+					ArgsUsage:   "<alias>",
+					Before:      s.Initialized,
+					Action:      s.MountRemove,
+				},
+				{
+					Name:        "list",
+					Aliases:     []string{"ls"},
+					Usage:       "Показать точки монтирования",
+					Description: "Показать псевдоним, путь, получателей и состояние git для каждого хранилища.",
+					Before:      s.Initialized,
+					Action:      s.MountsPrint,
+				},
+			},
+		},
+		{
+			Name:  "recipients",
+			Usage: "Управление получателями хранилища",
+			Description: "" +
+				"Эта команда позволяет добавлять и удалять получателей. Все секреты, " +
+				"зашифрованные для ближайшего файла получателей, перешифровываются для нового списка.",
+			Before: s.Initialized,
+			Action: s.RecipientsPrint,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "store",
+					Usage: "Хранилище или папка",
+				},
+			},
+			Subcommands: []*cli.Command{
+				{
+					Name:   "list",
+					Usage:  "Показать получателей",
+					Before: s.Initialized,
+					Action: s.RecipientsPrint,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "store",
+							Usage: "Хранилище или папка",
+						},
+					},
+				},
+				{
+					Name:      "add",
+					Usage:     "Добавить получателей и перешифровать хранилище",
+					ArgsUsage: "key [key...]",
+					Before:    s.Initialized,
+					Action:    s.RecipientsAdd,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "store",
+							Usage: "Хранилище или папка",
+						},
+					},
+				},
+				{
+					Name:      "remove",
+					Aliases:   []string{"rm"},
+					Usage:     "Удалить получателей и перешифровать хранилище",
+					ArgsUsage: "key [key...]",
+					Before:    s.Initialized,
+					Action:    s.RecipientsRemove,
+					Flags: []cli.Flag{
+						&cli.StringFlag{
+							Name:  "store",
+							Usage: "Хранилище или папка",
+						},
+						&cli.BoolFlag{
+							Name:    "force",
+							Aliases: []string{"f"},
+							Usage:   "Не запрашивать подтверждение",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "fsck",
+			Usage: "Проверить целостность хранилища",
+			Description: "" +
+				"Эта команда проверяет права доступа к файлам, получателей секретов, " +
+				"экспортированные открытые ключи, посторонние файлы, символические ссылки " +
+				"и незафиксированные изменения git. Каждое исправление требует подтверждения.",
+			Before: s.Initialized,
+			Action: s.Fsck,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "store",
+					Usage: "Store to operate on",
+				},
+			},
+		},
+		{
+			Name:  "audit",
+			Usage: "Проверить пароли на надежность, повторы и возраст",
+			Description: "" +
+				"Эта команда расшифровывает все секреты и сообщает о слабых, повторяющихся " +
+				"и давно не менявшихся паролях. Отчет содержит только имена секретов.",
+			ArgsUsage: "[prefix]",
+			Before:    s.Initialized,
+			Action:    s.Audit,
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "json",
+					Usage: "Вывести отчет в формате JSON",
+				},
+				&cli.IntFlag{
+					Name:  "max-age",
+					Usage: "Сообщать о паролях старше указанного количества дней, 0 отключает проверку",
+					Value: 365,
+				},
+				&cli.IntFlag{
+					Name:  "min-score",
+					Usage: "Минимальная оценка надежности от 0 до 4",
+					Value: 3,
+				},
+				&cli.IntFlag{
+					Name:  "jobs",
+					Usage: "Количество секретов, расшифровываемых параллельно",
+				},
+				&cli.StringFlag{
+					Name:  "hibp-file",
+					Usage: "Проверить пароли по локальному списку SHA-1 хешей Have I Been Pwned, отсортированному по хешу",
+				},
+			},
+		},
+		{
+			Name:   "unclip",
+			Usage:  "Очистить буфер обмена по истечении времени",
+			Hidden: true,
+			Action: s.Unclip,
+			Flags: []cli.Flag{
+				&cli.IntFlag{
+					Name:  "timeout",
+					Usage: "Время ожидания в секундах перед очисткой",
+					Value: 45,
+				},
+			},
+		},
 		{
 			Name:        "jsonapi",
 			Usage:       "Запустите keypass как jsonapi, например. для плагинов браузера",
@@ -222,7 +621,7 @@ func (s *Action) GetCommands() []*cli.Command {
 					Usage: "Слушайте и отвечайте на сообщения через stdin/stdout",
 					Description: "" +
 						"Keypass запускается в режиме прослушивания из подключаемых модулей браузера с использованием оболочки, " +
-						"указанной в манифестах узла обмена сообщениями.",
+						"указанной в манифестах узла обмена сообщениями. Сообщения обрабатываются, пока stdin не будет закрыт.",
 					Action: func(c *cli.Context) error {
 						return s.JSONAPI(withGlobalFlags(ctx, c), c)
 					},
@@ -85,6 +85,11 @@ func (s *Action) setConfigValue(key, value string) error {
 	if key != "path" {
 		value = strings.ToLower(value)
 	}
+	if key == "git" {
+		if err := storepass.CheckGitBackend(value); err != nil {
+			return err
+		}
+	}
 	o := reflect.ValueOf(s.Store).Elem()
 	for i := 0; i < o.NumField(); i++ {
 		jsonArg := o.Type().Field(i).Tag.Get("json")
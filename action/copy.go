@@ -0,0 +1,31 @@
+package action
+
+import (
+	"fmt"
+
+	"github.com/urfave/cli/v2"
+)
+
+// Copy ...
+func (s *Action) Copy(c *cli.Context) error {
+	force := c.Bool("force")
+
+	if len(c.Args().Slice()) != 2 {
+		return fmt.Errorf("Использование: keypass cp old-path new-path")
+	}
+
+	from := c.Args().Slice()[0]
+	to := c.Args().Slice()[1]
+
+	if !force {
+		exists, err := s.Store.Exists(to)
+		if err != nil {
+			return err
+		}
+		if exists && !askForConfirmation(fmt.Sprintf("%s уже существует. Перезаписать это?", to)) {
+			return fmt.Errorf("не перезаписывать ваш текущий секрет")
+		}
+	}
+
+	return s.Store.Copy(from, to)
+}
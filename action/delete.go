@@ -32,12 +32,16 @@ func (s *Action) Delete(c *cli.Context) error {
 		}
 	}
 
-	if recursive {
-		return s.Store.Prune(name)
+	if !recursive && s.Store.IsDir(name) {
+		return fmt.Errorf("Невозможно удалить  '%s': Это дирректория. Используйте 'keypass rm -r %s' для удаления", name, name)
 	}
 
-	if s.Store.IsDir(name) {
-		return fmt.Errorf("Невозможно удалить  '%s': Это дирректория. Используйте 'keypass rm -r %s' для удаления", name, name)
+	if s.Store.Trash && !c.Bool("permanent") {
+		return s.Store.Discard(name, recursive)
+	}
+
+	if recursive {
+		return s.Store.Prune(name)
 	}
 
 	return s.Store.Delete(name)
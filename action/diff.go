@@ -0,0 +1,120 @@
+package action
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ebladrocher/keypass/diff"
+	"github.com/ebladrocher/keypass/secret"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+const passwordMask = "********"
+
+// Diff ...
+func (s *Action) Diff(c *cli.Context) error {
+	name := c.Args().Get(0)
+	if name == "" {
+		return fmt.Errorf("Использование: %s diff <name> [rev1] [rev2]", s.Name)
+	}
+	rev1, rev2 := c.Args().Get(1), c.Args().Get(2)
+
+	if rev1 == "" {
+		revs, err := s.Store.History(name)
+		if err != nil {
+			return err
+		}
+		if len(revs) < 2 {
+			return fmt.Errorf("у %s нет предыдущих версий", name)
+		}
+		rev1 = revs[1].Hash[:8]
+	}
+
+	old, err := s.getRevision(name, rev1)
+	if err != nil {
+		return err
+	}
+	cur, err := s.getRevision(name, rev2)
+	if err != nil {
+		return err
+	}
+
+	label := rev2
+	if label == "" {
+		label = "текущая версия"
+	}
+	fmt.Println(color.RedString("--- %s (%s)", name, rev1))
+	fmt.Println(color.GreenString("+++ %s (%s)", name, label))
+
+	oldLines, curLines := diff.Split(string(old)), diff.Split(string(cur))
+	passwords := append(passwordsOf(oldLines), passwordsOf(curLines)...)
+	for _, l := range maskPassword(diff.Lines(oldLines, curLines), passwords) {
+		switch l.Op {
+		case diff.Insert:
+			fmt.Println(color.GreenString(l.String()))
+		case diff.Delete:
+			fmt.Println(color.RedString(l.String()))
+		default:
+			fmt.Println(l.String())
+		}
+	}
+	return nil
+}
+
+// maskPassword hides the first line of both versions and every occurrence
+// of the given passwords in the body
+func maskPassword(lines []diff.Line, passwords []string) []diff.Line {
+	a, b := 0, 0
+	for i, l := range lines {
+		first := false
+		switch l.Op {
+		case diff.Equal:
+			first = a == 0 || b == 0
+			a++
+			b++
+		case diff.Delete:
+			first = a == 0
+			a++
+		case diff.Insert:
+			first = b == 0
+			b++
+		}
+		if first {
+			lines[i].Text = passwordMask
+			continue
+		}
+		for _, pw := range passwords {
+			if pw != "" {
+				lines[i].Text = strings.Replace(lines[i].Text, pw, passwordMask, -1)
+			}
+		}
+	}
+	return lines
+}
+
+// passwordsOf returns the password and the previous passwords of a secret
+func passwordsOf(lines []string) []string {
+	if len(lines) < 1 {
+		return nil
+	}
+	passwords := []string{lines[0]}
+	history := false
+	for _, line := range lines[1:] {
+		if strings.EqualFold(strings.TrimSpace(line), secret.HistoryKey+":") {
+			history = true
+			continue
+		}
+		item := strings.TrimSpace(line)
+		if !history || !strings.HasPrefix(item, "- ") {
+			history = false
+			continue
+		}
+		// entries are "<date> <password>"
+		entry := strings.Trim(strings.TrimSpace(strings.TrimPrefix(item, "- ")), `"'`)
+		if p := strings.SplitN(entry, " ", 2); len(p) == 2 {
+			passwords = append(passwords, p[1])
+		}
+	}
+	return passwords
+}
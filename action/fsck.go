@@ -0,0 +1,18 @@
+package action
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Fsck ...
+func (s *Action) Fsck(c *cli.Context) error {
+	if err := s.Store.Fsck(c.String("store")); err != nil {
+		return err
+	}
+
+	fmt.Println(color.GreenString("Проверка завершена, проблем не осталось"))
+	return nil
+}
@@ -3,14 +3,19 @@ package action
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/ebladrocher/keypass/pass"
+	"github.com/ebladrocher/keypass/secret"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
 )
 
 const (
-	defaultLength = 16
+	defaultLength    = 16
+	defaultWords     = 6
+	defaultPINLength = 6
+	policyAttempts   = 100
 )
 
 // Generate ...
@@ -34,15 +39,45 @@ func (s *Action) Generate(c *cli.Context) error {
 		return fmt.Errorf("не удалось увидеть, если  %s существует: %s", name, err)
 	}
 
+	xkcd := c.Bool("xkcd")
+	pronounceable := c.Bool("pronounceable")
+	pin := c.Bool("pin")
+	if (xkcd && pronounceable) || (xkcd && pin) || (pronounceable && pin) {
+		return fmt.Errorf("флаги --xkcd, --pronounceable и --pin нельзя использовать вместе")
+	}
+
+	policy, err := s.Store.Policy(name)
+	if err != nil {
+		return err
+	}
+	policy = policy.Merge(policyFromFlags(c))
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
 	if length == "" {
-		length = strconv.Itoa(defaultLength)
-		if l, err := askForInt("Какой длины должен быть пароль ?", defaultLength); err == nil {
+		def, question := policy.Length(defaultLength), "Какой длины должен быть пароль ?"
+		switch {
+		case xkcd:
+			def, question = defaultWords, "Сколько слов должно быть в парольной фразе?"
+		case pin:
+			def, question = defaultPINLength, "Сколько цифр должно быть в PIN-коде?"
+		case pronounceable:
+			def = defaultLength
+		}
+		length = strconv.Itoa(def)
+		if l, err := askForInt(question, def); err == nil {
 			length = strconv.Itoa(l)
 		}
 	}
 
-	if !force {
-		if replacing && !askForConfirmation(fmt.Sprintf("Запись для  %s уже существует. Перезаписать это?", name)) {
+	rotate := replacing && !c.Bool("overwrite")
+	if !force && replacing {
+		question := fmt.Sprintf("Запись для  %s уже существует. Перезаписать это?", name)
+		if rotate {
+			question = fmt.Sprintf("Запись для  %s уже существует. Заменить пароль, сохранив остальное содержимое?", name)
+		}
+		if !askForConfirmation(question) {
 			return fmt.Errorf("не перезаписывать ваш текущий пароль ")
 		}
 	}
@@ -55,12 +90,50 @@ func (s *Action) Generate(c *cli.Context) error {
 		return fmt.Errorf("длина пароля должна быть больше чем  0")
 	}
 
-	password := pass.GeneratePassword(pwlen, !noSymbols)
+	var password []byte
+	var entropy float64
+	switch {
+	case xkcd:
+		o := pass.WordOptions{
+			Words:      pwlen,
+			Separator:  c.String("xkcd-sep"),
+			Capitalize: c.Bool("xkcd-capitalize"),
+			Digit:      c.Bool("xkcd-digit"),
+		}
+		password, err = generateChecked(policy, func() []byte { return pass.GeneratePassphrase(o) })
+		entropy = pass.PassphraseEntropy(o)
+	case pronounceable:
+		password, err = generateChecked(policy, func() []byte { return pass.GeneratePronounceable(pwlen) })
+		entropy = pass.PronounceableEntropy(pwlen)
+	case pin:
+		password, err = generateChecked(policy, func() []byte { return pass.GeneratePIN(pwlen) })
+		entropy = pass.PINEntropy(pwlen)
+	case policy.IsZero():
+		password = pass.GeneratePassword(pwlen, !noSymbols)
+		entropy = pass.CharsetEntropy(pwlen, pass.Charset(!noSymbols))
+	default:
+		if l := policy.Length(pwlen); l != pwlen {
+			fmt.Printf("Длина пароля изменена на %d согласно политике паролей\n", l)
+		}
+		var pw string
+		pw, err = policy.Generate(pwlen, pass.Charset(!noSymbols))
+		password = []byte(pw)
+		entropy = policy.Entropy(pwlen, pass.Charset(!noSymbols))
+	}
+	if err != nil {
+		return err
+	}
 
-	if err := s.Store.SetConfirm(name, password, s.confirmRecipients); err != nil {
+	if rotate {
+		if err := s.rotate(name, string(password), c.Bool("history")); err != nil {
+			return err
+		}
+	} else if err := s.Store.SetConfirm(name, password, s.confirmRecipients); err != nil {
 		return err
 	}
 
+	printEntropy(entropy)
+
 	if c.Bool("clip") {
 		return s.copyToClipboard(name, password)
 	}
@@ -72,3 +145,55 @@ func (s *Action) Generate(c *cli.Context) error {
 
 	return nil
 }
+
+// rotate replaces only the first line of an existing secret, optionally
+// keeping the old password in the history section
+func (s *Action) rotate(name, password string, history bool) error {
+	content, err := s.Store.Get(name)
+	if err != nil {
+		return err
+	}
+
+	sec := secret.Parse(content)
+	if history && sec.Password() != "" {
+		sec.AddHistory(sec.Password(), time.Now())
+	}
+	sec.SetPassword(password)
+
+	return s.Store.SetConfirmMessage(name, sec.Bytes(), fmt.Sprintf("Rotate password for %s.", name), s.confirmRecipients)
+}
+
+// generateChecked retries gen until the password satisfies the policy.
+// The xkcd, pronounceable and PIN generators know nothing about policies
+func generateChecked(policy pass.Policy, gen func() []byte) ([]byte, error) {
+	var err error
+	for i := 0; i < policyAttempts; i++ {
+		pw := gen()
+		if err = policy.Check(string(pw)); err == nil {
+			return pw, nil
+		}
+	}
+	return nil, fmt.Errorf("сгенерированный пароль не соответствует политике паролей: %s", err)
+}
+
+func printEntropy(bits float64) {
+	if bits < pass.RecommendedEntropy {
+		color.Red("Энтропия: %.1f бит (рекомендуется не менее %d бит)", bits, pass.RecommendedEntropy)
+		return
+	}
+	fmt.Printf("Энтропия: %.1f бит\n", bits)
+}
+
+func policyFromFlags(c *cli.Context) pass.Policy {
+	return pass.Policy{
+		MinLength:  c.Int("min-length"),
+		MaxLength:  c.Int("max-length"),
+		MinUpper:   c.Int("min-upper"),
+		MinLower:   c.Int("min-lower"),
+		MinDigits:  c.Int("min-digits"),
+		MinSymbols: c.Int("min-symbols"),
+		Allowed:    c.String("allowed"),
+		Forbidden:  c.String("forbidden"),
+		MaxRepeat:  c.Int("max-repeat"),
+	}
+}
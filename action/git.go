@@ -2,6 +2,7 @@ package action
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
@@ -18,9 +19,9 @@ func (s *Action) GitInit(c *cli.Context) error {
 	store := c.String("store")
 	sk := c.String("sign-key")
 	if sk == "" {
-		s, err := askForPrivateKey("Пожалуйста, выберите ключ для подписи Git Commits")
+		k, err := askForPrivateKey(s.Store.Crypto, "Пожалуйста, выберите ключ для подписи Git Commits")
 		if err == nil {
-			sk = s
+			sk = k
 		}
 	}
 
@@ -30,3 +31,44 @@ func (s *Action) GitInit(c *cli.Context) error {
 	fmt.Println(color.GreenString("Git инициализировано"))
 	return nil
 }
+
+// GitMergeDriver ...
+func (s *Action) GitMergeDriver(c *cli.Context) error {
+	if c.Args().Len() < 3 {
+		return fmt.Errorf("Использование: %s git merge-driver %%O %%A %%B [%%P]", s.Name)
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	conflict, err := s.Store.Merge(dir, c.Args().Get(0), c.Args().Get(1), c.Args().Get(2), c.Args().Get(3))
+	if err != nil {
+		return err
+	}
+	if conflict {
+		return fmt.Errorf("конфликт слияния в %s, исправьте его с помощью '%s edit'", c.Args().Get(3), s.Name)
+	}
+	return nil
+}
+
+// GitTextConv ...
+func (s *Action) GitTextConv(c *cli.Context) error {
+	if c.Args().Len() < 1 {
+		return fmt.Errorf("Использование: %s git textconv <file>", s.Name)
+	}
+
+	dir, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+
+	content, err := s.Store.TextConv(dir, c.Args().First())
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stdout.Write(content)
+	return err
+}
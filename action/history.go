@@ -0,0 +1,47 @@
+package action
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// History ...
+func (s *Action) History(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return fmt.Errorf("укажите секретное имя")
+	}
+
+	revs, err := s.Store.History(name)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range revs {
+		fmt.Printf("%s %s %s %s\n",
+			color.YellowString(r.Hash[:8]),
+			r.Date.Format("2006-01-02 15:04:05"),
+			color.CyanString(r.Author),
+			r.Message,
+		)
+	}
+	return nil
+}
+
+// Revert ...
+func (s *Action) Revert(c *cli.Context) error {
+	name := c.Args().Get(0)
+	rev := c.Args().Get(1)
+	if name == "" || rev == "" {
+		return fmt.Errorf("Использование: %s revert <name> <revision>", s.Name)
+	}
+
+	if err := s.Store.Revert(name, rev, s.confirmRecipients); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s восстановлен из ревизии %s\n", color.YellowString(name), rev)
+	return nil
+}
@@ -2,8 +2,9 @@ package action
 
 import (
 	"fmt"
+	"path/filepath"
 
-	"github.com/ebladrocher/keypass/crypto/gpg"
+	"github.com/ebladrocher/keypass/crypto"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
 )
@@ -21,6 +22,15 @@ func (s *Action) Init(c *cli.Context) error {
 	store := c.String("store")
 	nogit := c.Bool("nogit")
 
+	if folder := c.String("path"); folder != "" {
+		return s.initFolder(filepath.Join(store, folder), c.Args().Slice())
+	}
+
+	backend, err := s.Store.Backend(c.String("crypto"))
+	if err != nil {
+		return err
+	}
+
 	if !hasConfig() {
 		s.Store.AutoPush = true
 		s.Store.AutoPull = true
@@ -29,25 +39,35 @@ func (s *Action) Init(c *cli.Context) error {
 		s.Store.PersistKeys = true
 		s.Store.LoadKeys = false
 		s.Store.ClipTimeout = 45
+		s.Store.Trash = true
+	}
+
+	if file := c.String("import-key"); file != "" {
+		if err := importPrivateKey(backend, file); err != nil {
+			return err
+		}
 	}
 
 	keys := c.Args().Slice()
 	if len(keys) < 1 {
-		nk, err := askForPrivateKey("Пожалуйста, выберите закрытый ключ для шифрования:")
+		if err := generateIdentity(backend); err != nil {
+			return err
+		}
+		nk, err := askForPrivateKey(backend, "Пожалуйста, выберите закрытый ключ для шифрования:")
 		if err != nil {
 			return err
 		}
 		keys = []string{nk}
 	}
 
-	if err := s.Store.Init(store, keys...); err != nil {
+	if err := s.Store.Init(store, c.String("crypto"), keys...); err != nil {
 		return err
 	}
 
 	fmt.Printf(color.GreenString("Хранилище паролей инициализировано для: "))
 	for _, recipient := range s.Store.ListRecipients(store) {
 		r := "0x" + recipient
-		if kl, err := gpg.ListPublicKeys(recipient); err == nil && len(kl) > 0 {
+		if kl, err := backend.ListPublicKeys(recipient); err == nil && len(kl) > 0 {
 			r = kl[0].OneLine()
 		}
 		color.Yellow(r)
@@ -64,3 +84,82 @@ func (s *Action) Init(c *cli.Context) error {
 
 	return s.GitInit(c)
 }
+
+func (s *Action) initFolder(name string, keys []string) error {
+	if !s.Store.Initialized() {
+		return fmt.Errorf("хранилище паролей не инициализировано. Попробуйте '%s init'", s.Name)
+	}
+
+	backend := s.Store.CryptoFor(name)
+	if len(keys) < 1 {
+		nk, err := askForPrivateKey(backend, "Пожалуйста, выберите закрытый ключ для шифрования:")
+		if err != nil {
+			return err
+		}
+		keys = []string{nk}
+	}
+
+	if err := s.Store.InitFolder(name, keys...); err != nil {
+		return err
+	}
+
+	fmt.Printf(color.GreenString("Папка %s инициализирована для: ", name))
+	for _, recipient := range s.Store.ListRecipients(name) {
+		r := "0x" + recipient
+		if kl, err := backend.ListPublicKeys(recipient); err == nil && len(kl) > 0 {
+			r = kl[0].OneLine()
+		}
+		color.Yellow(r)
+	}
+	return nil
+}
+
+type identityGenerator interface {
+	GenerateIdentity() (string, error)
+}
+
+// generateIdentity offers to create a new identity if the backend
+// can generate its own keys and there are none yet
+func generateIdentity(c crypto.Crypto) error {
+	g, ok := c.(identityGenerator)
+	if !ok {
+		if _, ok := c.(privateKeyImporter); ok {
+			if kl, err := c.ListPrivateKeys(); err == nil && len(kl) < 1 {
+				color.Yellow("Не найдено ни одного закрытого ключа %s. Ключи GnuPG 2.1+ нужно экспортировать:\n"+
+					"  gpg --export-secret-keys --armor <id> > key.asc\n"+
+					"  keypass init --crypto %s --import-key key.asc", c.Name(), c.Name())
+			}
+		}
+		return nil
+	}
+	if kl, err := c.ListPrivateKeys(); err != nil || len(kl) > 0 {
+		return err
+	}
+	if !askForConfirmation(fmt.Sprintf("Не найдено ни одного ключа %s. Создать новый?", c.Name())) {
+		return nil
+	}
+	r, err := g.GenerateIdentity()
+	if err != nil {
+		return fmt.Errorf("не удалось создать ключ: %s", err)
+	}
+	fmt.Printf("Создан новый ключ: %s\n", color.YellowString(r))
+	return nil
+}
+
+type privateKeyImporter interface {
+	ImportPrivateKey(string) error
+}
+
+// importPrivateKey adds an exported secret key to the keyring of backends
+// that can not read the keyring of the gpg agent
+func importPrivateKey(c crypto.Crypto, filename string) error {
+	i, ok := c.(privateKeyImporter)
+	if !ok {
+		return fmt.Errorf("бэкенд %s не поддерживает импорт ключей", c.Name())
+	}
+	if err := i.ImportPrivateKey(filename); err != nil {
+		return fmt.Errorf("не удалось импортировать ключ: %s", err)
+	}
+	fmt.Printf("Ключ импортирован из %s\n", filename)
+	return nil
+}
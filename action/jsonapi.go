@@ -3,8 +3,10 @@ package action
 import (
 	"context"
 	"os"
+	"os/signal"
 	"runtime"
 	"strings"
+	"syscall"
 
 	"github.com/ebladrocher/keypass/jsonapi"
 	"github.com/ebladrocher/keypass/jsonapi/manifest"
@@ -13,10 +15,25 @@ import (
 	"github.com/urfave/cli/v2"
 )
 
-// JSONAPI reads a json message on stdin and responds on stdout
+// JSONAPI reads json messages on stdin and responds on stdout until stdin
+// is closed or keypass is interrupted
 func (s *Action) JSONAPI(ctx context.Context, c *cli.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	go func() {
+		select {
+		case <-sigs:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
 	api := jsonapi.API{Store: s.Store, Reader: os.Stdin, Writer: os.Stdout}
-	if err := api.ReadAndRespond(ctx); err != nil {
+	if err := api.Listen(ctx); err != nil && err != context.Canceled {
 		return api.RespondError(err)
 	}
 	return nil
@@ -0,0 +1,81 @@
+package action
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ebladrocher/keypass/storepass"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// MountsPrint ...
+func (s *Action) MountsPrint(c *cli.Context) error {
+	fmt.Printf("%s (%s)\n", color.GreenString(s.Name), s.Store.Path)
+	s.printMount("", s.Store.Path)
+
+	mounts := s.Store.Mounts()
+	for _, alias := range s.Store.MountPoints() {
+		fmt.Printf("%s -> %s\n", color.GreenString(alias), mounts[alias])
+		s.printMount(alias, mounts[alias])
+	}
+	return nil
+}
+
+func (s *Action) printMount(alias, path string) {
+	recipients := s.Store.ListRecipients(alias)
+	fmt.Printf("  получатели: %s\n", color.YellowString(strings.Join(recipients, ", ")))
+
+	changes, err := s.Store.GitChanges(alias)
+	switch {
+	case err == storepass.ErrGitNotInit:
+		fmt.Printf("  git: %s\n", color.RedString("не инициализирован"))
+	case err != nil:
+		fmt.Printf("  git: %s\n", color.RedString(err.Error()))
+	case len(changes) > 0:
+		fmt.Printf("  git: %s\n", color.YellowString("%d незафиксированных изменений", len(changes)))
+	default:
+		fmt.Printf("  git: %s\n", color.GreenString("чисто"))
+	}
+}
+
+// MountAdd ...
+func (s *Action) MountAdd(c *cli.Context) error {
+	if c.Args().Len() < 2 {
+		return fmt.Errorf("Использование: %s mount add <alias> <path> [keys...]", s.Name)
+	}
+
+	alias := c.Args().Get(0)
+	path := c.Args().Get(1)
+	keys := c.Args().Slice()[2:]
+
+	if err := s.Store.AddMount(alias, path, c.String("crypto"), keys...); err != nil {
+		return fmt.Errorf("не удалось примонтировать %s: %s", alias, err)
+	}
+
+	if err := writeConfig(s.Store); err != nil {
+		return err
+	}
+
+	fmt.Printf("Хранилище %s примонтировано в %s\n", color.YellowString(path), color.GreenString(alias))
+	return nil
+}
+
+// MountRemove ...
+func (s *Action) MountRemove(c *cli.Context) error {
+	if c.Args().Len() != 1 {
+		return fmt.Errorf("Использование: %s mount remove <alias>", s.Name)
+	}
+
+	alias := c.Args().First()
+	if err := s.Store.RemoveMount(alias); err != nil {
+		return err
+	}
+
+	if err := writeConfig(s.Store); err != nil {
+		return err
+	}
+
+	fmt.Printf("Точка монтирования %s удалена\n", color.GreenString(alias))
+	return nil
+}
@@ -0,0 +1,52 @@
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ebladrocher/keypass/otp"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// OTP ...
+func (s *Action) OTP(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return fmt.Errorf("укажите секретное имя")
+	}
+
+	content, err := s.Store.Get(name)
+	if err != nil {
+		return err
+	}
+
+	k, err := otp.FromContent(content)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	code, err := k.Code(now)
+	if err != nil {
+		return err
+	}
+
+	// HOTP codes must never be reused, so the counter is saved first
+	if k.Type == otp.HOTP {
+		if err := s.Store.SetConfirm(name, k.Increment(content), s.confirmRecipients); err != nil {
+			return err
+		}
+	}
+
+	if c.Bool("clip") {
+		return s.copyToClipboard(name, []byte(code))
+	}
+
+	if k.Type == otp.TOTP {
+		fmt.Printf("%s (осталось %d секунд)\n", color.YellowString(code), k.Remaining(now))
+		return nil
+	}
+	color.Yellow(code)
+	return nil
+}
@@ -0,0 +1,67 @@
+package action
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// RecipientsPrint ...
+func (s *Action) RecipientsPrint(c *cli.Context) error {
+	store := c.String("store")
+	backend := s.Store.CryptoFor(store)
+
+	fmt.Println(color.GreenString("Получатели хранилища %s:", s.storeName(store)))
+	for _, r := range s.Store.ListRecipients(store) {
+		line := "0x" + r
+		if kl, err := backend.ListPublicKeys(r); err == nil && len(kl) > 0 {
+			line = kl[0].OneLine()
+		}
+		fmt.Printf(" - %s\n", color.YellowString(line))
+	}
+	return nil
+}
+
+// RecipientsAdd ...
+func (s *Action) RecipientsAdd(c *cli.Context) error {
+	store := c.String("store")
+	keys := c.Args().Slice()
+	if len(keys) < 1 {
+		return fmt.Errorf("Использование: %s recipients add [--store store] key [key...]", s.Name)
+	}
+
+	if err := s.Store.AddRecipients(store, keys...); err != nil {
+		return fmt.Errorf("не удалось добавить получателей: %s", err)
+	}
+
+	fmt.Println(color.GreenString("Получатели добавлены, секреты хранилища %s перешифрованы", s.storeName(store)))
+	return nil
+}
+
+// RecipientsRemove ...
+func (s *Action) RecipientsRemove(c *cli.Context) error {
+	store := c.String("store")
+	keys := c.Args().Slice()
+	if len(keys) < 1 {
+		return fmt.Errorf("Использование: %s recipients remove [--store store] key [key...]", s.Name)
+	}
+
+	if !c.Bool("force") && !askForConfirmation(fmt.Sprintf("Удалить получателей %v и перешифровать хранилище %s?", keys, s.storeName(store))) {
+		return nil
+	}
+
+	if err := s.Store.RemoveRecipients(store, keys...); err != nil {
+		return fmt.Errorf("не удалось удалить получателей: %s", err)
+	}
+
+	fmt.Println(color.GreenString("Получатели удалены, секреты хранилища %s перешифрованы", s.storeName(store)))
+	return nil
+}
+
+func (s *Action) storeName(store string) string {
+	if store == "" {
+		return "<root>"
+	}
+	return store
+}
@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"fmt"
 
-	"github.com/atotto/clipboard"
+	"github.com/ebladrocher/keypass/secret"
 	"github.com/fatih/color"
 	"github.com/urfave/cli/v2"
 )
@@ -16,15 +16,28 @@ func (s *Action) Show(c *cli.Context) error {
 		return fmt.Errorf("укажите секретное имя")
 	}
 
-	if s.Store.IsDir(name) {
+	rev := c.String("revision")
+	if rev == "" && s.Store.IsDir(name) {
 		return s.List(c)
 	}
 
-	content, err := s.Store.Get(name)
+	content, err := s.getRevision(name, rev)
 	if err != nil {
 		return err
 	}
 
+	if key := c.Args().Get(1); key != "" {
+		value, found := secret.Parse(content).Get(key)
+		if !found {
+			return fmt.Errorf("ключ %s не найден в %s", key, name)
+		}
+		if c.Bool("clip") {
+			return s.copyToClipboard(name, []byte(value))
+		}
+		fmt.Println(value)
+		return nil
+	}
+
 	if c.Bool("clip") {
 		return s.copyToClipboard(name, content)
 	}
@@ -34,6 +47,13 @@ func (s *Action) Show(c *cli.Context) error {
 	return nil
 }
 
+func (s *Action) getRevision(name, rev string) ([]byte, error) {
+	if rev == "" {
+		return s.Store.Get(name)
+	}
+	return s.Store.GetRevision(name, rev)
+}
+
 func (s *Action) copyToClipboard(name string, content []byte) error {
 	content = bytes.TrimSpace(content)
 
@@ -44,7 +64,7 @@ func (s *Action) copyToClipboard(name string, content []byte) error {
 	}
 	line := lines[0]
 
-	if err := clipboard.WriteAll(string(line)); err != nil {
+	if err := clip.WriteAll(string(line)); err != nil {
 		return err
 	}
 	
@@ -0,0 +1,60 @@
+package action
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ebladrocher/keypass/storepass"
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// Sync ...
+func (s *Action) Sync(c *cli.Context) error {
+	failed := 0
+	for _, alias := range append([]string{""}, s.Store.MountPoints()...) {
+		name := alias
+		if name == "" {
+			name = "<root>"
+		}
+		fmt.Printf("%s\n", color.GreenString("Синхронизация %s", name))
+
+		res, err := s.Store.Sync(alias)
+		switch {
+		case err == storepass.ErrGitNotInit:
+			fmt.Println(color.YellowString("  git не инициализирован, пропущено"))
+			continue
+		case err == storepass.ErrGitNoRemote:
+			fmt.Println(color.YellowString("  нет удаленного репозитория, пропущено"))
+			continue
+		case err != nil:
+			fmt.Println(color.RedString("  ошибка: %s", err))
+			failed++
+			if res == nil {
+				continue
+			}
+		}
+		printSync(res)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("не удалось синхронизировать хранилищ: %d", failed)
+	}
+	return nil
+}
+
+func printSync(res *storepass.SyncResult) {
+	fmt.Printf("  новых: %d, изменено: %d, удалено: %d\n", len(res.Added), len(res.Changed), len(res.Removed))
+	for _, n := range res.Added {
+		fmt.Printf("    + %s\n", n)
+	}
+	for _, n := range res.Changed {
+		fmt.Printf("    ~ %s\n", n)
+	}
+	for _, n := range res.Removed {
+		fmt.Printf("    - %s\n", n)
+	}
+	if len(res.Imported) > 0 {
+		fmt.Printf("  импортированы ключи: %s\n", color.YellowString(strings.Join(res.Imported, ", ")))
+	}
+}
@@ -0,0 +1,73 @@
+package action
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/urfave/cli/v2"
+)
+
+// TrashList ...
+func (s *Action) TrashList(c *cli.Context) error {
+	entries, err := s.Store.TrashList()
+	if err != nil {
+		return err
+	}
+
+	if len(entries) < 1 {
+		fmt.Println("Корзина пуста")
+		return nil
+	}
+
+	for _, e := range entries {
+		fmt.Printf("%s %s %s\n",
+			color.YellowString(e.ID),
+			e.Deleted.Local().Format("2006-01-02 15:04:05"),
+			e.Name,
+		)
+	}
+	return nil
+}
+
+// TrashRestore ...
+func (s *Action) TrashRestore(c *cli.Context) error {
+	name := c.Args().First()
+	if name == "" {
+		return fmt.Errorf("Использование: %s trash restore <name>", s.Name)
+	}
+
+	if err := s.Store.Restore(name, c.String("id"), c.Bool("force")); err != nil {
+		return err
+	}
+
+	fmt.Printf("%s восстановлен из корзины\n", color.YellowString(name))
+	return nil
+}
+
+// TrashEmpty ...
+func (s *Action) TrashEmpty(c *cli.Context) error {
+	days := c.Int("older-than")
+	if days < 0 {
+		return fmt.Errorf("--older-than не может быть отрицательным")
+	}
+	before := time.Now().AddDate(0, 0, -days)
+
+	if !c.Bool("force") {
+		q := "Удалить все секреты из корзины безвозвратно?"
+		if days > 0 {
+			q = fmt.Sprintf("Удалить безвозвратно секреты, удаленные более %d дней назад?", days)
+		}
+		if !askForConfirmation(q) {
+			return nil
+		}
+	}
+
+	removed, err := s.Store.EmptyTrash(before)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Удалено из корзины: %d\n", removed)
+	return nil
+}
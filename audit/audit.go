@@ -0,0 +1,226 @@
+package audit
+
+import (
+	"context"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ebladrocher/keypass/pass"
+	"github.com/ebladrocher/keypass/secret"
+)
+
+// Store is the part of the password store the audit needs
+type Store interface {
+	List() ([]string, error)
+	Get(name string) ([]byte, error)
+	LastModified(name string) (time.Time, error)
+}
+
+// Options ...
+type Options struct {
+	// Prefix limits the audit to secrets below this folder
+	Prefix string
+	// Jobs is the number of secrets decrypted in parallel
+	Jobs int
+	// MaxAge flags passwords not changed for longer, zero disables it
+	MaxAge time.Duration
+	// MinScore is the lowest strength score that is not reported as weak
+	MinScore int
+	// Breaches checks passwords against known breaches, nil disables it
+	Breaches Breaches
+}
+
+// Breaches ...
+type Breaches interface {
+	Count(password string) (int, error)
+}
+
+// Report never contains any passwords, only the names of the secrets
+type Report struct {
+	Checked    int        `json:"checked"`
+	Weak       []Weak     `json:"weak"`
+	Duplicates [][]string `json:"duplicates"`
+	Old        []Old      `json:"old"`
+	Breached   []Breached `json:"breached"`
+	Errors     []Failure  `json:"errors"`
+}
+
+// Breached ...
+type Breached struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// Weak ...
+type Weak struct {
+	Name    string  `json:"name"`
+	Score   int     `json:"score"`
+	Bits    float64 `json:"bits"`
+	Warning string  `json:"warning,omitempty"`
+}
+
+// Old ...
+type Old struct {
+	Name     string    `json:"name"`
+	Modified time.Time `json:"modified"`
+	Days     int       `json:"days"`
+}
+
+// Failure ...
+type Failure struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// Problems returns the number of findings
+func (r *Report) Problems() int {
+	return len(r.Weak) + len(r.Duplicates) + len(r.Old) + len(r.Breached) + len(r.Errors)
+}
+
+type result struct {
+	name     string
+	password string
+	modified time.Time
+	err      error
+}
+
+// Run decrypts every secret in parallel and checks the passwords
+func Run(ctx context.Context, s Store, o Options) (*Report, error) {
+	names, err := list(s, o.Prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := o.Jobs
+	if jobs < 1 {
+		jobs = runtime.NumCPU()
+	}
+
+	in := make(chan string)
+	out := make(chan result)
+	wg := &sync.WaitGroup{}
+	for i := 0; i < jobs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for name := range in {
+				out <- decrypt(s, name, o.MaxAge > 0)
+			}
+		}()
+	}
+	go func() {
+		defer close(in)
+		for _, name := range names {
+			select {
+			case in <- name:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+
+	r := &Report{
+		Weak:       []Weak{},
+		Duplicates: [][]string{},
+		Old:        []Old{},
+		Breached:   []Breached{},
+		Errors:     []Failure{},
+	}
+	passwords := make(map[string][]string, len(names))
+	now := time.Now()
+	for res := range out {
+		if res.err != nil {
+			r.Errors = append(r.Errors, Failure{Name: res.name, Error: res.err.Error()})
+			continue
+		}
+		r.Checked++
+		if res.password == "" {
+			continue
+		}
+
+		passwords[res.password] = append(passwords[res.password], res.name)
+
+		if st := pass.EstimateStrength(res.password); st.Score < o.MinScore {
+			r.Weak = append(r.Weak, Weak{Name: res.name, Score: st.Score, Bits: st.Bits(), Warning: st.Warning})
+		}
+
+		if o.MaxAge > 0 && !res.modified.IsZero() && now.Sub(res.modified) > o.MaxAge {
+			r.Old = append(r.Old, Old{Name: res.name, Modified: res.modified, Days: int(now.Sub(res.modified).Hours() / 24)})
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	for pw, names := range passwords {
+		if len(names) > 1 {
+			sort.Strings(names)
+			r.Duplicates = append(r.Duplicates, names)
+		}
+		if o.Breaches == nil {
+			continue
+		}
+		count, err := o.Breaches.Count(pw)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			for _, name := range names {
+				r.Breached = append(r.Breached, Breached{Name: name, Count: count})
+			}
+		}
+	}
+
+	r.sort()
+	return r, nil
+}
+
+func list(s Store, prefix string) ([]string, error) {
+	all, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+	prefix = strings.Trim(prefix, "/")
+	if prefix == "" {
+		return all, nil
+	}
+
+	names := make([]string, 0, len(all))
+	for _, name := range all {
+		if name == prefix || strings.HasPrefix(name, prefix+"/") {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+func decrypt(s Store, name string, age bool) result {
+	content, err := s.Get(name)
+	if err != nil {
+		return result{name: name, err: err}
+	}
+	res := result{
+		name:     name,
+		password: secret.Parse(content).Password(),
+	}
+	if age {
+		// secrets outside of git have no history, so they are never old
+		res.modified, _ = s.LastModified(name)
+	}
+	return res
+}
+
+func (r *Report) sort() {
+	sort.Slice(r.Weak, func(i, j int) bool { return r.Weak[i].Name < r.Weak[j].Name })
+	sort.Slice(r.Duplicates, func(i, j int) bool { return r.Duplicates[i][0] < r.Duplicates[j][0] })
+	sort.Slice(r.Old, func(i, j int) bool { return r.Old[i].Name < r.Old[j].Name })
+	sort.Slice(r.Breached, func(i, j int) bool { return r.Breached[i].Name < r.Breached[j].Name })
+	sort.Slice(r.Errors, func(i, j int) bool { return r.Errors[i].Name < r.Errors[j].Name })
+}
@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const hibpLineMax = 256
+
+// HIBP looks up SHA-1 hashes in a local copy of the Have I Been Pwned
+// password list ordered by hash. Lines look like `<SHA1>:<count>`
+type HIBP struct {
+	f    *os.File
+	size int64
+}
+
+// OpenHIBP ...
+func OpenHIBP(path string) (*HIBP, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	h := &HIBP{f: f, size: fi.Size()}
+	first, _, err := h.lineAt(0)
+	if err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	if hash, _ := splitHIBP(first); len(hash) != 2*sha1.Size {
+		_ = f.Close()
+		return nil, fmt.Errorf("%s не похож на список SHA-1 хешей HIBP", path)
+	}
+	return h, nil
+}
+
+// Close ...
+func (h *HIBP) Close() error {
+	return h.f.Close()
+}
+
+// Count returns how often the password appears in breaches
+func (h *HIBP) Count(password string) (int, error) {
+	return h.Lookup(fmt.Sprintf("%X", sha1.Sum([]byte(password))))
+}
+
+// Lookup does a binary search for the upper case hex SHA-1 hash
+func (h *HIBP) Lookup(hash string) (int, error) {
+	hash = strings.ToUpper(hash)
+
+	lo, hi := int64(0), h.size
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		line, end, err := h.lineAt(mid)
+		if err == io.EOF {
+			hi = mid
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+
+		cur, count := splitHIBP(line)
+		switch {
+		case cur == hash:
+			return count, nil
+		case cur < hash:
+			lo = end
+		default:
+			hi = mid
+		}
+	}
+	return 0, nil
+}
+
+// lineAt returns the first line starting at or after off and the offset
+// of the following line
+func (h *HIBP) lineAt(off int64) (string, int64, error) {
+	start := off
+	if off > 0 {
+		start = off - 1
+	}
+
+	buf := make([]byte, 2*hibpLineMax)
+	n, err := h.f.ReadAt(buf, start)
+	if err != nil && err != io.EOF {
+		return "", 0, err
+	}
+	buf = buf[:n]
+
+	if off > 0 {
+		i := bytes.IndexByte(buf, '\n')
+		if i < 0 {
+			return "", 0, io.EOF
+		}
+		buf = buf[i+1:]
+		start += int64(i + 1)
+	}
+	if len(buf) == 0 {
+		return "", 0, io.EOF
+	}
+
+	i := bytes.IndexByte(buf, '\n')
+	if i < 0 {
+		if start+int64(len(buf)) < h.size {
+			return "", 0, fmt.Errorf("слишком длинная строка в списке HIBP")
+		}
+		i = len(buf)
+	}
+	return strings.TrimSpace(string(buf[:i])), start + int64(i) + 1, nil
+}
+
+func splitHIBP(line string) (string, int) {
+	parts := strings.SplitN(line, ":", 2)
+	hash := strings.ToUpper(parts[0])
+	if len(parts) < 2 {
+		return hash, 1
+	}
+	count, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return hash, 1
+	}
+	return hash, count
+}
@@ -0,0 +1,318 @@
+package age
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/ebladrocher/keypass/crypto"
+	"github.com/ebladrocher/keypass/fsutil"
+)
+
+const (
+	// IDFile ...
+	IDFile = ".age-recipients"
+	// Ext ...
+	Ext = ".age"
+
+	fileMode = 0600
+	dirPerm  = 0700
+
+	ageHeader   = "age-encryption.org/v1"
+	armorHeader = "-----BEGIN AGE ENCRYPTED FILE-----"
+)
+
+var (
+	// IdentitiesFile default location of the age identities
+	IdentitiesFile = "~/.config/keypass/age-identities"
+	// Debug ...
+	Debug = false
+)
+
+// Age ...
+type Age struct {
+	// PassphraseFunc is used to unlock a passphrase (scrypt) protected
+	// identities file
+	PassphraseFunc func(string) (string, error)
+
+	identitiesFile string
+	identities     []age.Identity
+	mu             sync.Mutex
+}
+
+// New ...
+func New(identitiesFile string) *Age {
+	if identitiesFile == "" {
+		identitiesFile = IdentitiesFile
+	}
+	return &Age{
+		identitiesFile: fsutil.CleanPath(identitiesFile),
+	}
+}
+
+// Name ...
+func (a *Age) Name() string {
+	return "age"
+}
+
+// Ext ...
+func (a *Age) Ext() string {
+	return Ext
+}
+
+// IDFile ...
+func (a *Age) IDFile() string {
+	return IDFile
+}
+
+// Encrypt ...
+func (a *Age) Encrypt(path string, content []byte, recipients []string, alwaysTrust bool) error {
+	rs := make([]age.Recipient, 0, len(recipients))
+	for _, r := range recipients {
+		rcpt, err := age.ParseX25519Recipient(r)
+		if err != nil {
+			return fmt.Errorf("неверный получатель age %s: %s", r, err)
+		}
+		rs = append(rs, rcpt)
+	}
+	if len(rs) < 1 {
+		return fmt.Errorf("не указаны получатели")
+	}
+
+	if Debug {
+		fmt.Printf("age.Encrypt: %s %+v\n", path, recipients)
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := age.Encrypt(buf, rs...)
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), fileMode)
+}
+
+// Decrypt ...
+func (a *Age) Decrypt(path string) ([]byte, error) {
+	ids, err := a.loadIdentities()
+	if err != nil {
+		return nil, err
+	}
+
+	if Debug {
+		fmt.Printf("age.Decrypt: %s\n", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	r, err := age.Decrypt(f, ids...)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(r)
+}
+
+// ListPublicKeys ...
+func (a *Age) ListPublicKeys(search ...string) (crypto.KeyList, error) {
+	if len(search) < 1 {
+		return a.ListPrivateKeys()
+	}
+
+	kl := make(crypto.KeyList, 0, len(search))
+	for _, s := range search {
+		if _, err := age.ParseX25519Recipient(s); err != nil {
+			continue
+		}
+		kl = append(kl, crypto.Key{
+			Fingerprint: s,
+			Description: s,
+			Useable:     true,
+		})
+	}
+	return kl, nil
+}
+
+// ListPrivateKeys ...
+func (a *Age) ListPrivateKeys(search ...string) (crypto.KeyList, error) {
+	if !fsutil.IsFile(a.identitiesFile) {
+		return crypto.KeyList{}, nil
+	}
+
+	ids, err := a.loadIdentities()
+	if err != nil {
+		return crypto.KeyList{}, err
+	}
+
+	kl := make(crypto.KeyList, 0, len(ids))
+	for _, id := range ids {
+		xid, ok := id.(*age.X25519Identity)
+		if !ok {
+			continue
+		}
+		r := xid.Recipient().String()
+		if len(search) > 0 && !contains(search, r) {
+			continue
+		}
+		kl = append(kl, crypto.Key{
+			Fingerprint: r,
+			Description: r,
+			Useable:     true,
+		})
+	}
+	return kl, nil
+}
+
+// ImportPublicKey checks that filename contains valid age recipients.
+// age has no keyring, so there is nothing else to import
+func (a *Age) ImportPublicKey(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	_, err = age.ParseRecipients(f)
+	return err
+}
+
+// ExportPublicKey ...
+func (a *Age) ExportPublicKey(id, filename string) error {
+	if _, err := age.ParseX25519Recipient(id); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, []byte(id+"\n"), fileMode)
+}
+
+// GenerateIdentity creates a new X25519 identity, appends it to the
+// identities file and returns its recipient
+func (a *Age) GenerateIdentity() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if fsutil.IsFile(a.identitiesFile) {
+		buf, err := ioutil.ReadFile(a.identitiesFile)
+		if err != nil {
+			return "", err
+		}
+		if isEncrypted(buf) {
+			return "", fmt.Errorf("файл идентификаторов %s защищен паролем", a.identitiesFile)
+		}
+	}
+
+	id, err := age.GenerateX25519Identity()
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(a.identitiesFile), dirPerm); err != nil {
+		return "", err
+	}
+	f, err := os.OpenFile(a.identitiesFile, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	fmt.Fprintf(f, "# created: %s\n", time.Now().Format(time.RFC3339))
+	fmt.Fprintf(f, "# public key: %s\n", id.Recipient())
+	if _, err := fmt.Fprintf(f, "%s\n", id); err != nil {
+		return "", err
+	}
+
+	a.identities = append(a.identities, id)
+	return id.Recipient().String(), nil
+}
+
+func (a *Age) loadIdentities() ([]age.Identity, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.identities) > 0 {
+		return a.identities, nil
+	}
+
+	buf, err := ioutil.ReadFile(a.identitiesFile)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось прочитать идентификаторы age: %s", err)
+	}
+
+	if isEncrypted(buf) {
+		buf, err = a.unlock(buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ids, err := age.ParseIdentities(bytes.NewReader(buf))
+	if err != nil {
+		return nil, fmt.Errorf("не удалось разобрать идентификаторы age: %s", err)
+	}
+
+	a.identities = ids
+	return ids, nil
+}
+
+func (a *Age) unlock(buf []byte) ([]byte, error) {
+	if a.PassphraseFunc == nil {
+		return nil, fmt.Errorf("файл идентификаторов %s защищен паролем", a.identitiesFile)
+	}
+
+	pw, err := a.PassphraseFunc(fmt.Sprintf("Введите пароль для %s", a.identitiesFile))
+	if err != nil {
+		return nil, err
+	}
+	id, err := age.NewScryptIdentity(pw)
+	if err != nil {
+		return nil, err
+	}
+
+	var src io.Reader = bytes.NewReader(buf)
+	if strings.HasPrefix(string(buf), armorHeader) {
+		src = armor.NewReader(src)
+	}
+	r, err := age.Decrypt(src, id)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось расшифровать %s: %s", a.identitiesFile, err)
+	}
+	return ioutil.ReadAll(r)
+}
+
+func isEncrypted(buf []byte) bool {
+	return bytes.HasPrefix(buf, []byte(ageHeader)) || bytes.HasPrefix(buf, []byte(armorHeader))
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package crypto
+
+// Crypto ...
+type Crypto interface {
+	Name() string
+	Ext() string
+	IDFile() string
+	Encrypt(path string, content []byte, recipients []string, alwaysTrust bool) error
+	Decrypt(path string) ([]byte, error)
+	ListPublicKeys(search ...string) (KeyList, error)
+	ListPrivateKeys(search ...string) (KeyList, error)
+	ImportPublicKey(filename string) error
+	ExportPublicKey(id, filename string) error
+}
+
+// RecipientReader is implemented by backends which can tell the
+// recipients of an encrypted file without decrypting it
+type RecipientReader interface {
+	RecipientsOf(path string) ([]string, error)
+}
+
+// KeyList ...
+type KeyList []Key
+
+// Key ...
+type Key struct {
+	Fingerprint string
+	Description string
+	Useable     bool
+}
+
+// OneLine ...
+func (k Key) OneLine() string {
+	if k.Description == "" {
+		return k.Fingerprint
+	}
+	return k.Description
+}
+
+// UseableKeys ...
+func (kl KeyList) UseableKeys() KeyList {
+	nkl := make(KeyList, 0, len(kl))
+	for _, k := range kl {
+		if !k.Useable {
+			continue
+		}
+		nkl = append(nkl, k)
+	}
+	return nkl
+}
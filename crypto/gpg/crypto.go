@@ -0,0 +1,115 @@
+package gpg
+
+import (
+	"strings"
+
+	"github.com/ebladrocher/keypass/crypto"
+)
+
+const (
+	// IDFile ...
+	IDFile = ".gpg-id"
+	// Ext ...
+	Ext = ".gpg"
+)
+
+// GPG ...
+type GPG struct{}
+
+// New ...
+func New() *GPG {
+	return &GPG{}
+}
+
+// Name ...
+func (g *GPG) Name() string {
+	return "gpg"
+}
+
+// Ext ...
+func (g *GPG) Ext() string {
+	return Ext
+}
+
+// IDFile ...
+func (g *GPG) IDFile() string {
+	return IDFile
+}
+
+// Encrypt ...
+func (g *GPG) Encrypt(path string, content []byte, recipients []string, alwaysTrust bool) error {
+	return Encrypt(path, content, recipients, alwaysTrust)
+}
+
+// Decrypt ...
+func (g *GPG) Decrypt(path string) ([]byte, error) {
+	return Decrypt(path)
+}
+
+// ListPublicKeys ...
+func (g *GPG) ListPublicKeys(search ...string) (crypto.KeyList, error) {
+	kl, err := ListPublicKeys(search...)
+	return kl.cryptoKeys(), err
+}
+
+// ListPrivateKeys ...
+func (g *GPG) ListPrivateKeys(search ...string) (crypto.KeyList, error) {
+	kl, err := ListPrivateKeys(search...)
+	return kl.cryptoKeys(), err
+}
+
+// ImportPublicKey ...
+func (g *GPG) ImportPublicKey(filename string) error {
+	return ImportPublicKey(filename)
+}
+
+// ExportPublicKey ...
+func (g *GPG) ExportPublicKey(id, filename string) error {
+	return ExportPublicKey(id, filename)
+}
+
+// RecipientsOf returns the fingerprints of the keys path is encrypted for.
+// Key ids not found in the keyring are returned as is
+func (g *GPG) RecipientsOf(path string) ([]string, error) {
+	ids, err := ListRecipients(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) < 1 {
+		return ids, nil
+	}
+
+	search := make([]string, 0, len(ids))
+	for _, id := range ids {
+		search = append(search, "0x"+id)
+	}
+	kl, err := ListPublicKeys(search...)
+	if err != nil {
+		kl = KeyList{}
+	}
+
+	fps := make([]string, 0, len(ids))
+	for _, id := range ids {
+		fp := id
+		for _, k := range kl {
+			if _, found := k.SubKeys[id]; found || strings.HasSuffix(k.Fingerprint, id) {
+				fp = k.Fingerprint
+				break
+			}
+		}
+		fps = append(fps, fp)
+	}
+	return fps, nil
+}
+
+func (kl KeyList) cryptoKeys() crypto.KeyList {
+	ckl := make(crypto.KeyList, 0, len(kl))
+	for _, k := range kl {
+		ckl = append(ckl, crypto.Key{
+			Fingerprint: k.Fingerprint,
+			Description: k.OneLine(),
+			Useable:     k.IsUseable(),
+		})
+	}
+	return ckl
+}
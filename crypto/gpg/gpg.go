@@ -314,6 +314,30 @@ func Decrypt(path string) ([]byte, error) {
 	return cmd.Output()
 }
 
+// ListRecipients returns the key ids a file is encrypted for
+func ListRecipients(path string) ([]string, error) {
+	args := append(GPGArgs, "--batch", "--list-only", "--status-fd=1", "--decrypt", path)
+	cmd := exec.Command(GPGBin, args...)
+	if Debug {
+		fmt.Printf("gpg.ListRecipients: %s %+v\n", cmd.Path, cmd.Args)
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, 5)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != "[GNUPG:]" || fields[1] != "ENC_TO" {
+			continue
+		}
+		ids = append(ids, fields[2])
+	}
+	return ids, nil
+}
+
 func parseTS(str string) time.Time {
 	t := time.Time{}
 
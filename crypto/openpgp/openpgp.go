@@ -0,0 +1,567 @@
+package openpgp
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	pgp "github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
+	"github.com/ebladrocher/keypass/crypto"
+	"github.com/ebladrocher/keypass/crypto/gpg"
+	"github.com/ebladrocher/keypass/fsutil"
+)
+
+const (
+	fileMode = 0600
+	dirPerm  = 0700
+
+	maxPassphraseAttempts = 3
+)
+
+var (
+	// PubringFile default location of the keyring imported keys are added to
+	PubringFile = "~/.config/keypass/openpgp/pubring.asc"
+	// SecringFile default location of the keyring ImportPrivateKey adds
+	// exported secret keys to
+	SecringFile = "~/.config/keypass/openpgp/secring.asc"
+	// Keyrings are additional keyrings read if they exist. GnuPG 2.1 and
+	// later keep keys in pubring.kbx and private-keys-v1.d, which are not
+	// supported, so only the legacy gnupg keyrings are read directly
+	Keyrings = []string{
+		"~/.config/keypass/openpgp/secring.asc",
+		"~/.gnupg/pubring.gpg",
+		"~/.gnupg/secring.gpg",
+	}
+	// Debug ...
+	Debug = false
+)
+
+// OpenPGP is an in-process implementation of the gpg backend. It reads and
+// writes the same *.gpg files and .gpg-id recipients as the gpg binary.
+// It can not read the keyring of GnuPG 2.1 and later, the secret key has to
+// be exported with `gpg --export-secret-keys --armor <id>` and imported with
+// ImportPrivateKey (keypass init --crypto openpgp --import-key <file>)
+type OpenPGP struct {
+	// PassphraseFunc is used to unlock passphrase protected private keys
+	PassphraseFunc func(string) (string, error)
+
+	pubring  string
+	secring  string
+	keyrings []string
+	entities pgp.EntityList
+	loaded   bool
+	mu       sync.Mutex
+	// unlockMu guards the private keys of the shared entities, which are
+	// unlocked in place and stay unlocked
+	unlockMu sync.Mutex
+}
+
+// New ...
+func New(pubring string, keyrings ...string) *OpenPGP {
+	if pubring == "" {
+		pubring = PubringFile
+	}
+	if len(keyrings) < 1 {
+		keyrings = Keyrings
+	}
+	o := &OpenPGP{
+		pubring:  fsutil.CleanPath(pubring),
+		secring:  fsutil.CleanPath(SecringFile),
+		keyrings: make([]string, 0, len(keyrings)+1),
+	}
+	for _, k := range keyrings {
+		o.keyrings = append(o.keyrings, fsutil.CleanPath(k))
+	}
+	if !contains(o.keyrings, o.secring) {
+		o.keyrings = append(o.keyrings, o.secring)
+	}
+	return o
+}
+
+// Name ...
+func (o *OpenPGP) Name() string {
+	return "openpgp"
+}
+
+// Ext ...
+func (o *OpenPGP) Ext() string {
+	return gpg.Ext
+}
+
+// IDFile ...
+func (o *OpenPGP) IDFile() string {
+	return gpg.IDFile
+}
+
+// Encrypt ignores alwaysTrust since there is no trust database. Every
+// recipient found in the keyrings is used
+func (o *OpenPGP) Encrypt(path string, content []byte, recipients []string, alwaysTrust bool) error {
+	el, err := o.keyring()
+	if err != nil {
+		return err
+	}
+
+	to := make([]*pgp.Entity, 0, len(recipients))
+	for _, r := range recipients {
+		found := false
+		for _, e := range el {
+			if !matches(e, r) {
+				continue
+			}
+			to = append(to, e)
+			found = true
+			break
+		}
+		if !found {
+			return fmt.Errorf("открытый ключ %s не найден", r)
+		}
+	}
+
+	if Debug {
+		fmt.Printf("openpgp.Encrypt: %s %+v\n", path, recipients)
+	}
+
+	buf := &bytes.Buffer{}
+	w, err := pgp.Encrypt(buf, to, nil, nil, &packet.Config{
+		DefaultCompressionAlgo: packet.CompressionNone,
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(content); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), dirPerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, buf.Bytes(), fileMode)
+}
+
+// Decrypt ...
+func (o *OpenPGP) Decrypt(path string) ([]byte, error) {
+	el, err := o.keyring()
+	if err != nil {
+		return nil, err
+	}
+
+	if Debug {
+		fmt.Printf("openpgp.Decrypt: %s\n", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	// the private keys are only used while reading the header, the body
+	// is decrypted with the session key
+	o.unlockMu.Lock()
+	md, err := pgp.ReadMessage(f, el, o.prompt(), nil)
+	o.unlockMu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.ReadAll(md.UnverifiedBody)
+}
+
+// SigningKey returns the private key matching id, unlocked for signing
+// git commits
+func (o *OpenPGP) SigningKey(id string) (*pgp.Entity, error) {
+	el, err := o.keyring()
+	if err != nil {
+		return nil, err
+	}
+
+	o.unlockMu.Lock()
+	defer o.unlockMu.Unlock()
+
+	for _, e := range el {
+		if e.PrivateKey == nil || !matches(e, id) {
+			continue
+		}
+		keys := []*packet.PrivateKey{e.PrivateKey}
+		for _, sk := range e.Subkeys {
+			if sk.PrivateKey != nil {
+				keys = append(keys, sk.PrivateKey)
+			}
+		}
+		for _, k := range keys {
+			if !k.Encrypted {
+				continue
+			}
+			if o.PassphraseFunc == nil {
+				return nil, fmt.Errorf("закрытый ключ защищен паролем")
+			}
+			pw, err := o.PassphraseFunc(fmt.Sprintf("Введите пароль для ключа 0x%X", k.KeyId))
+			if err != nil {
+				return nil, err
+			}
+			if err := k.Decrypt([]byte(pw)); err != nil {
+				return nil, fmt.Errorf("неверный пароль")
+			}
+		}
+		return e, nil
+	}
+	return nil, fmt.Errorf("закрытый ключ %s не найден", id)
+}
+
+// ListPublicKeys ...
+func (o *OpenPGP) ListPublicKeys(search ...string) (crypto.KeyList, error) {
+	return o.listKeys(false, search...)
+}
+
+// ListPrivateKeys ...
+func (o *OpenPGP) ListPrivateKeys(search ...string) (crypto.KeyList, error) {
+	return o.listKeys(true, search...)
+}
+
+// ImportPublicKey adds the keys in filename to the pubring
+func (o *OpenPGP) ImportPublicKey(filename string) error {
+	buf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	el, err := readKeyRing(buf)
+	if err != nil {
+		return err
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(o.pubring), dirPerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(o.pubring, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	for _, e := range el {
+		if err := writeArmored(f, e); err != nil {
+			return err
+		}
+	}
+
+	o.loaded = false
+	return nil
+}
+
+// ImportPrivateKey adds the secret keys exported to filename, armored or
+// binary, to the secring
+func (o *OpenPGP) ImportPrivateKey(filename string) error {
+	buf, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	el, err := readKeyRing(buf)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, e := range el {
+		if e.PrivateKey != nil {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("в %s нет закрытых ключей", filename)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if err := os.MkdirAll(filepath.Dir(o.secring), dirPerm); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(o.secring, os.O_CREATE|os.O_APPEND|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	// the keys are stored as exported, re-serializing them would need
+	// the passphrase of protected keys
+	if bytes.Contains(buf, []byte("-----BEGIN PGP")) {
+		_, err = f.Write(append(buf, '\n'))
+	} else {
+		err = writeArmoredBytes(f, pgp.PrivateKeyType, buf)
+	}
+	if err != nil {
+		return err
+	}
+
+	o.loaded = false
+	return nil
+}
+
+// ExportPublicKey ...
+func (o *OpenPGP) ExportPublicKey(id, filename string) error {
+	el, err := o.keyring()
+	if err != nil {
+		return err
+	}
+	for _, e := range el {
+		if !matches(e, id) {
+			continue
+		}
+		buf := &bytes.Buffer{}
+		if err := writeArmored(buf, e); err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filename, buf.Bytes(), fileMode)
+	}
+	return fmt.Errorf("открытый ключ %s не найден", id)
+}
+
+// RecipientsOf returns the fingerprints of the keys path is encrypted for.
+// Key ids not found in the keyrings are returned as is
+func (o *OpenPGP) RecipientsOf(path string) ([]string, error) {
+	el, err := o.keyring()
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+
+	fps := make([]string, 0, 5)
+	packets := packet.NewReader(f)
+	for {
+		p, err := packets.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		ek, ok := p.(*packet.EncryptedKey)
+		if !ok {
+			break
+		}
+		fp := fmt.Sprintf("%016X", ek.KeyId)
+		if keys := el.KeysById(ek.KeyId); len(keys) > 0 {
+			fp = fingerprint(keys[0].Entity)
+		}
+		fps = append(fps, fp)
+	}
+	return fps, nil
+}
+
+func (o *OpenPGP) listKeys(private bool, search ...string) (crypto.KeyList, error) {
+	el, err := o.keyring()
+	if err != nil {
+		return crypto.KeyList{}, err
+	}
+
+	kl := make(crypto.KeyList, 0, len(el))
+	for _, e := range el {
+		if private && e.PrivateKey == nil {
+			continue
+		}
+		if len(search) > 0 && !matchesAny(e, search) {
+			continue
+		}
+		kl = append(kl, cryptoKey(e))
+	}
+	return kl, nil
+}
+
+func (o *OpenPGP) keyring() (pgp.EntityList, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.loaded {
+		return o.entities, nil
+	}
+
+	el := make(pgp.EntityList, 0, 10)
+	for _, fn := range append([]string{o.pubring}, o.keyrings...) {
+		if !fsutil.IsFile(fn) {
+			continue
+		}
+		buf, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return nil, err
+		}
+		kr, err := readKeyRing(buf)
+		if err != nil {
+			fmt.Printf("Не удалось прочитать связку ключей %s: %s\n", fn, err)
+			continue
+		}
+		el = merge(el, kr)
+	}
+
+	o.entities = el
+	o.loaded = true
+	return el, nil
+}
+
+// prompt unlocks the private keys of a message. It must be called with
+// unlockMu held
+func (o *OpenPGP) prompt() pgp.PromptFunction {
+	attempts := 0
+	return func(keys []pgp.Key, symmetric bool) ([]byte, error) {
+		if o.PassphraseFunc == nil {
+			return nil, fmt.Errorf("закрытый ключ защищен паролем")
+		}
+		if attempts >= maxPassphraseAttempts {
+			return nil, fmt.Errorf("неверный пароль")
+		}
+		attempts++
+
+		for _, k := range keys {
+			if k.PrivateKey == nil || !k.PrivateKey.Encrypted {
+				continue
+			}
+			pw, err := o.PassphraseFunc(fmt.Sprintf("Введите пароль для ключа 0x%X", k.PublicKey.KeyId))
+			if err != nil {
+				return nil, err
+			}
+			if err := k.PrivateKey.Decrypt([]byte(pw)); err == nil {
+				return nil, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+func readKeyRing(buf []byte) (pgp.EntityList, error) {
+	if bytes.Contains(buf, []byte("-----BEGIN PGP")) {
+		return pgp.ReadArmoredKeyRing(bytes.NewReader(buf))
+	}
+	return pgp.ReadKeyRing(bytes.NewReader(buf))
+}
+
+func writeArmored(w io.Writer, e *pgp.Entity) error {
+	aw, err := armor.Encode(w, pgp.PublicKeyType, nil)
+	if err != nil {
+		return err
+	}
+	if err := e.Serialize(aw); err != nil {
+		return err
+	}
+	if err := aw.Close(); err != nil {
+		return err
+	}
+	_, err = w.Write([]byte("\n"))
+	return err
+}
+
+func writeArmoredBytes(w io.Writer, blockType string, buf []byte) error {
+	aw, err := armor.Encode(w, blockType, nil)
+	if err != nil {
+		return err
+	}
+	if _, err := aw.Write(buf); err != nil {
+		return err
+	}
+	if err := aw.Close(); err != nil {
+		return err
+	}
+	_, err = w.Write([]byte("\n"))
+	return err
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
+}
+
+// merge adds the entities of b to a. Entities with a private key replace
+// public only copies of the same key
+func merge(a, b pgp.EntityList) pgp.EntityList {
+	for _, e := range b {
+		dup := false
+		for i, x := range a {
+			if !bytes.Equal(x.PrimaryKey.Fingerprint, e.PrimaryKey.Fingerprint) {
+				continue
+			}
+			if x.PrivateKey == nil && e.PrivateKey != nil {
+				a[i] = e
+			}
+			dup = true
+			break
+		}
+		if !dup {
+			a = append(a, e)
+		}
+	}
+	return a
+}
+
+func fingerprint(e *pgp.Entity) string {
+	return strings.ToUpper(hex.EncodeToString(e.PrimaryKey.Fingerprint))
+}
+
+func matches(e *pgp.Entity, search string) bool {
+	fp := fingerprint(e)
+	id := strings.ToUpper(strings.TrimPrefix(strings.TrimPrefix(search, "0x"), "0X"))
+	if len(id) >= 8 && strings.HasSuffix(fp, id) {
+		return true
+	}
+	for name := range e.Identities {
+		if strings.Contains(strings.ToLower(name), strings.ToLower(search)) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAny(e *pgp.Entity, search []string) bool {
+	for _, s := range search {
+		if matches(e, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func cryptoKey(e *pgp.Entity) crypto.Key {
+	fp := fingerprint(e)
+	name := ""
+	if id := e.PrimaryIdentity(); id != nil {
+		name = id.Name
+	}
+	short := fp
+	if len(fp) > 16 {
+		short = fp[len(fp)-16:]
+	}
+	_, useable := e.EncryptionKey(time.Now())
+	return crypto.Key{
+		Fingerprint: fp,
+		Description: fmt.Sprintf("0x%s - %s", short, name),
+		Useable:     useable,
+	}
+}
@@ -0,0 +1,102 @@
+package diff
+
+import "strings"
+
+// Op ...
+type Op int
+
+const (
+	// Equal ...
+	Equal Op = iota
+	// Insert ...
+	Insert
+	// Delete ...
+	Delete
+)
+
+// Line is a line of a diff
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// String formats the line like a unified diff
+func (l Line) String() string {
+	switch l.Op {
+	case Insert:
+		return "+" + l.Text
+	case Delete:
+		return "-" + l.Text
+	default:
+		return " " + l.Text
+	}
+}
+
+// Split splits text into lines without the trailing newline
+func Split(text string) []string {
+	if text == "" {
+		return []string{}
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// Lines returns the line based diff turning a into b
+func Lines(a, b []string) []Line {
+	out := make([]Line, 0, len(a)+len(b))
+	i, j := 0, 0
+	for _, m := range lcs(a, b) {
+		for ; i < m[0]; i++ {
+			out = append(out, Line{Delete, a[i]})
+		}
+		for ; j < m[1]; j++ {
+			out = append(out, Line{Insert, b[j]})
+		}
+		out = append(out, Line{Equal, a[i]})
+		i++
+		j++
+	}
+	for ; i < len(a); i++ {
+		out = append(out, Line{Delete, a[i]})
+	}
+	for ; j < len(b); j++ {
+		out = append(out, Line{Insert, b[j]})
+	}
+	return out
+}
+
+// lcs returns the index pairs of a longest common subsequence of a and b.
+// Secrets are small, so the quadratic table is fine
+func lcs(a, b []string) [][2]int {
+	n, m := len(a), len(b)
+	t := make([][]int, n+1)
+	for i := range t {
+		t[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				t[i][j] = t[i+1][j+1] + 1
+			case t[i+1][j] >= t[i][j+1]:
+				t[i][j] = t[i+1][j]
+			default:
+				t[i][j] = t[i][j+1]
+			}
+		}
+	}
+
+	pairs := make([][2]int, 0, t[0][0])
+	for i, j := 0, 0; i < n && j < m; {
+		switch {
+		case a[i] == b[j]:
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case t[i+1][j] >= t[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
@@ -0,0 +1,52 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		a    string
+		b    string
+		want []Line
+	}{
+		{
+			name: "equal",
+			a:    "pw\nuser: bob\n",
+			b:    "pw\nuser: bob\n",
+			want: []Line{{Equal, "pw"}, {Equal, "user: bob"}},
+		},
+		{
+			name: "changed",
+			a:    "pw\nuser: bob\n",
+			b:    "new\nuser: bob\n",
+			want: []Line{{Delete, "pw"}, {Insert, "new"}, {Equal, "user: bob"}},
+		},
+		{
+			name: "appended",
+			a:    "pw\n",
+			b:    "pw\nurl: a\n",
+			want: []Line{{Equal, "pw"}, {Insert, "url: a"}},
+		},
+		{
+			name: "removed",
+			a:    "pw\nurl: a\n",
+			b:    "pw\n",
+			want: []Line{{Equal, "pw"}, {Delete, "url: a"}},
+		},
+		{
+			name: "from empty",
+			a:    "",
+			b:    "pw\n",
+			want: []Line{{Insert, "pw"}},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Lines(Split(tc.a), Split(tc.b)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("Lines = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package diff
+
+const (
+	markerOurs   = "<<<<<<< ours"
+	markerSep    = "======="
+	markerTheirs = ">>>>>>> theirs"
+)
+
+// Merge3 merges the changes from base to ours and from base to theirs.
+// Conflicting changes are kept between git style conflict markers and
+// reported by the second return value
+func Merge3(base, ours, theirs []string) ([]string, bool) {
+	matchOurs := matches(base, ours)
+	matchTheirs := matches(base, theirs)
+
+	out := make([]string, 0, len(ours)+len(theirs))
+	conflict := false
+	io, ia, ib := 0, 0, 0
+	for {
+		// find the next base line kept by both sides
+		o := io
+		for o < len(base) && (matchOurs[o] < 0 || matchTheirs[o] < 0) {
+			o++
+		}
+
+		if o == io && o < len(base) && matchOurs[o] == ia && matchTheirs[o] == ib {
+			out = append(out, base[o])
+			io, ia, ib = io+1, ia+1, ib+1
+			continue
+		}
+
+		ea, eb := len(ours), len(theirs)
+		if o < len(base) {
+			ea, eb = matchOurs[o], matchTheirs[o]
+		}
+
+		b, a, t := base[io:o], ours[ia:ea], theirs[ib:eb]
+		switch {
+		case equal(a, b):
+			out = append(out, t...)
+		case equal(t, b), equal(a, t):
+			out = append(out, a...)
+		default:
+			conflict = true
+			out = append(out, markerOurs)
+			out = append(out, a...)
+			out = append(out, markerSep)
+			out = append(out, t...)
+			out = append(out, markerTheirs)
+		}
+
+		if o >= len(base) {
+			return out, conflict
+		}
+		io, ia, ib = o, ea, eb
+	}
+}
+
+// matches maps every line of base to its line in other or -1
+func matches(base, other []string) []int {
+	m := make([]int, len(base))
+	for i := range m {
+		m[i] = -1
+	}
+	for _, p := range lcs(base, other) {
+		m[p[0]] = p[1]
+	}
+	return m
+}
+
+func equal(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,108 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge3(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		base     string
+		ours     string
+		theirs   string
+		want     string
+		conflict bool
+	}{
+		{
+			name:   "unchanged",
+			base:   "pw\nuser: bob\n",
+			ours:   "pw\nuser: bob\n",
+			theirs: "pw\nuser: bob\n",
+			want:   "pw\nuser: bob\n",
+		},
+		{
+			name:   "ours changed",
+			base:   "pw\nuser: bob\nurl: a\n",
+			ours:   "pw\nuser: alice\nurl: a\n",
+			theirs: "pw\nuser: bob\nurl: a\n",
+			want:   "pw\nuser: alice\nurl: a\n",
+		},
+		{
+			name:   "theirs changed",
+			base:   "pw\nuser: bob\nurl: a\n",
+			ours:   "pw\nuser: bob\nurl: a\n",
+			theirs: "new\nuser: bob\nurl: a\n",
+			want:   "new\nuser: bob\nurl: a\n",
+		},
+		{
+			name:   "different lines changed",
+			base:   "pw\nuser: bob\nurl: a\n",
+			ours:   "new\nuser: bob\nurl: a\n",
+			theirs: "pw\nuser: bob\nurl: b\n",
+			want:   "new\nuser: bob\nurl: b\n",
+		},
+		{
+			name:   "same change",
+			base:   "pw\nuser: bob\n",
+			ours:   "new\nuser: bob\n",
+			theirs: "new\nuser: bob\n",
+			want:   "new\nuser: bob\n",
+		},
+		{
+			name:   "same line deleted",
+			base:   "pw\nuser: bob\nurl: a\n",
+			ours:   "pw\nurl: a\n",
+			theirs: "pw\nurl: a\n",
+			want:   "pw\nurl: a\n",
+		},
+		{
+			name:     "both appended",
+			base:     "pw\n",
+			ours:     "pw\nuser: bob\n",
+			theirs:   "pw\nurl: a\n",
+			want:     "pw\n<<<<<<< ours\nuser: bob\n=======\nurl: a\n>>>>>>> theirs\n",
+			conflict: true,
+		},
+		{
+			name:   "both appended the same",
+			base:   "pw\n",
+			ours:   "pw\nuser: bob\n",
+			theirs: "pw\nuser: bob\n",
+			want:   "pw\nuser: bob\n",
+		},
+		{
+			name:     "conflict",
+			base:     "pw\nuser: bob\n",
+			ours:     "ours\nuser: bob\n",
+			theirs:   "theirs\nuser: bob\n",
+			want:     "<<<<<<< ours\nours\n=======\ntheirs\n>>>>>>> theirs\nuser: bob\n",
+			conflict: true,
+		},
+		{
+			name:     "empty base",
+			base:     "",
+			ours:     "pw\nuser: bob\n",
+			theirs:   "other\nuser: bob\n",
+			want:     "<<<<<<< ours\npw\nuser: bob\n=======\nother\nuser: bob\n>>>>>>> theirs\n",
+			conflict: true,
+		},
+		{
+			name:   "empty base same content",
+			base:   "",
+			ours:   "pw\n",
+			theirs: "pw\n",
+			want:   "pw\n",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, conflict := Merge3(Split(tc.base), Split(tc.ours), Split(tc.theirs))
+			if want := Split(tc.want); !reflect.DeepEqual(got, want) {
+				t.Errorf("Merge3 = %q, want %q", got, want)
+			}
+			if conflict != tc.conflict {
+				t.Errorf("conflict = %t, want %t", conflict, tc.conflict)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ebladrocher/keypass/git"
+)
+
+// Git runs the git binary
+type Git struct {
+	path string
+}
+
+// New ...
+func New(path string) *Git {
+	return &Git{path: path}
+}
+
+// Available reports whether the git binary is installed
+func Available() bool {
+	_, err := exec.LookPath("git")
+	return err == nil
+}
+
+// Clone ...
+func Clone(repo, path string) error {
+	cmd := exec.Command("git", "clone", repo, path)
+	cmd.Stdout = os.Stdout
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+// Name ...
+func (g *Git) Name() string {
+	return "cli"
+}
+
+// Init ...
+func (g *Git) Init() error {
+	return g.run("init")
+}
+
+// Add ...
+func (g *Git) Add(files ...string) error {
+	args := []string{"add", "--all"}
+	args = append(args, files...)
+	if err := g.run(args...); err != nil {
+		return fmt.Errorf("не удалось добавить файлы в git: %v", err)
+	}
+	return nil
+}
+
+// Commit ...
+func (g *Git) Commit(msg string) error {
+	if changes, err := g.staged(); err == nil && !changes {
+		return git.ErrNothingToCommit
+	}
+	if err := g.run("commit", "-m", msg); err != nil {
+		return fmt.Errorf("не удалось добавить файлы в git: %v", err)
+	}
+	return nil
+}
+
+// ConfigGet ...
+func (g *Git) ConfigGet(key string) (string, error) {
+	out, err := g.output("config", "--get", key)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ConfigSet ...
+func (g *Git) ConfigSet(key, value string) error {
+	return g.run("config", "--local", key, value)
+}
+
+// Push ...
+func (g *Git) Push(remote, branch string) error {
+	return g.run("push", remote, branch)
+}
+
+// Pull ...
+func (g *Git) Pull(remote, branch string, rebase bool) error {
+	if rebase {
+		return g.run("pull", "--rebase", remote, branch)
+	}
+	return g.run("pull", remote, branch)
+}
+
+// Status ...
+func (g *Git) Status() ([]string, error) {
+	out, err := g.output("status", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, 5)
+	for _, line := range strings.Split(string(out), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
+
+// Head ...
+func (g *Git) Head() (string, error) {
+	out, err := g.output("rev-parse", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Branch ...
+func (g *Git) Branch() (string, error) {
+	out, err := g.output("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Log ...
+func (g *Git) Log(path string) ([]git.Commit, error) {
+	out, err := g.output("log", "--follow", "--format=%H%x1f%an <%ae>%x1f%at%x1f%s", "--", path)
+	if err != nil {
+		return nil, err
+	}
+
+	commits := make([]git.Commit, 0, 10)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Split(line, "\x1f")
+		if len(fields) != 4 {
+			continue
+		}
+		ts, err := strconv.ParseInt(fields[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		commits = append(commits, git.Commit{
+			Hash:    fields[0],
+			Author:  fields[1],
+			Date:    time.Unix(ts, 0),
+			Message: fields[3],
+		})
+	}
+	return commits, nil
+}
+
+// Show ...
+func (g *Git) Show(rev, path string) ([]byte, error) {
+	rel, err := filepath.Rel(g.path, path)
+	if err != nil {
+		return nil, err
+	}
+	return g.output("show", rev+":./"+filepath.ToSlash(rel))
+}
+
+// Changes ...
+func (g *Git) Changes(from, to string) ([]git.Change, error) {
+	out, err := g.output("diff", "--name-status", "--no-renames", from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]git.Change, 0, 10)
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.SplitN(line, "\t", 2)
+		if len(fields) != 2 || fields[0] == "" {
+			continue
+		}
+		changes = append(changes, git.Change{Op: fields[0][0], Path: fields[1]})
+	}
+	return changes, nil
+}
+
+func (g *Git) staged() (bool, error) {
+	cmd := exec.Command("git", "diff", "--cached", "--quiet")
+	cmd.Dir = g.path
+	err := cmd.Run()
+	if err == nil {
+		return false, nil
+	}
+	if ee, ok := err.(*exec.ExitError); ok && ee.ExitCode() == 1 {
+		return true, nil
+	}
+	return false, err
+}
+
+func (g *Git) run(args ...string) error {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.path
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return cmd.Run()
+}
+
+func (g *Git) output(args ...string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = g.path
+	cmd.Stdout = buf
+	cmd.Stderr = ioutil.Discard
+
+	if err := cmd.Run(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
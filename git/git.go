@@ -0,0 +1,48 @@
+package git
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrNothingToCommit ...
+	ErrNothingToCommit = fmt.Errorf("нет изменений для фиксации")
+)
+
+// Git is the version control of a password store
+type Git interface {
+	Name() string
+	Init() error
+	// Add stages files and folders including deletions, like `git add --all`
+	Add(files ...string) error
+	Commit(msg string) error
+	ConfigGet(key string) (string, error)
+	ConfigSet(key, value string) error
+	Push(remote, branch string) error
+	Pull(remote, branch string, rebase bool) error
+	// Status returns the uncommitted changes in porcelain format
+	Status() ([]string, error)
+	Head() (string, error)
+	Branch() (string, error)
+	// Log returns the commits touching path, newest first
+	Log(path string) ([]Commit, error)
+	// Show returns the content of path in revision rev
+	Show(rev, path string) ([]byte, error)
+	// Changes returns the files changed between two revisions
+	Changes(from, to string) ([]Change, error)
+}
+
+// Commit ...
+type Commit struct {
+	Hash    string
+	Author  string
+	Date    time.Time
+	Message string
+}
+
+// Change is a file added (A), modified (M) or deleted (D)
+type Change struct {
+	Op   byte
+	Path string
+}
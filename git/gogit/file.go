@@ -0,0 +1,80 @@
+package gogit
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"sync"
+
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/plumbing/protocol/packp"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/client"
+	"github.com/go-git/go-git/v5/plumbing/transport/server"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+)
+
+var fileTransport sync.Once
+
+// installFileTransport serves file:// remotes in process if there is no
+// git binary. The file transport of go-git runs git-upload-pack and
+// git-receive-pack, so without git push, pull and clone would fail
+func installFileTransport() {
+	fileTransport.Do(func() {
+		if _, err := exec.LookPath("git"); err == nil {
+			return
+		}
+		client.InstallProtocol("file", local{server.NewServer(loader{})})
+	})
+}
+
+// loader opens bare and non-bare repositories for file:// remotes
+type loader struct{}
+
+func (loader) Load(ep *transport.Endpoint) (storer.Storer, error) {
+	for _, dir := range []string{ep.Path, filepath.Join(ep.Path, ".git")} {
+		fs := osfs.New(dir)
+		if _, err := fs.Stat("config"); err == nil {
+			return filesystem.NewStorage(fs, cache.NewObjectLRUDefault()), nil
+		}
+	}
+	return nil, transport.ErrRepositoryNotFound
+}
+
+// local serves file:// remotes. The upload-pack of go-git fails on
+// commits the remote does not know, which is the normal case when pulling
+// into a diverged branch, so those are dropped from the request
+type local struct {
+	transport.Transport
+}
+
+func (l local) NewUploadPackSession(ep *transport.Endpoint, auth transport.AuthMethod) (transport.UploadPackSession, error) {
+	sess, err := l.Transport.NewUploadPackSession(ep, auth)
+	if err != nil {
+		return nil, err
+	}
+	st, err := loader{}.Load(ep)
+	if err != nil {
+		return nil, err
+	}
+	return &uploadSession{UploadPackSession: sess, storer: st}, nil
+}
+
+type uploadSession struct {
+	transport.UploadPackSession
+	storer storer.EncodedObjectStorer
+}
+
+func (s *uploadSession) UploadPack(ctx context.Context, req *packp.UploadPackRequest) (*packp.UploadPackResponse, error) {
+	haves := make([]plumbing.Hash, 0, len(req.Haves))
+	for _, h := range req.Haves {
+		if err := s.storer.HasEncodedObject(h); err == nil {
+			haves = append(haves, h)
+		}
+	}
+	req.Haves = haves
+	return s.UploadPackSession.UploadPack(ctx, req)
+}
@@ -0,0 +1,565 @@
+package gogit
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/git"
+	gogit "github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// Git is a pure Go implementation using go-git. Operations go-git can not
+// do, like merging diverged branches, are handed to the fallback if there
+// is one
+type Git struct {
+	// SignKey returns the unlocked private key for user.signingkey. Without
+	// it commits can not be signed and fail if commit.gpgsign is set
+	SignKey func(id string) (*openpgp.Entity, error)
+
+	path     string
+	fallback git.Git
+	repo     *gogit.Repository
+	// mu serializes the reads audit runs in parallel, go-git repositories
+	// are not safe for concurrent use
+	mu sync.Mutex
+}
+
+// New ...
+func New(path string, fallback git.Git) *Git {
+	installFileTransport()
+	return &Git{
+		path:     path,
+		fallback: fallback,
+	}
+}
+
+// Clone ...
+func Clone(repo, path string) error {
+	installFileTransport()
+	auth, err := authFor(repo)
+	if err != nil {
+		return err
+	}
+	_, err = gogit.PlainClone(path, false, &gogit.CloneOptions{
+		URL:      repo,
+		Auth:     auth,
+		Progress: os.Stdout,
+	})
+	return err
+}
+
+// Name ...
+func (g *Git) Name() string {
+	return "gogit"
+}
+
+// Init ...
+func (g *Git) Init() error {
+	r, err := gogit.PlainInit(g.path, false)
+	if err != nil {
+		return err
+	}
+	g.repo = r
+	return nil
+}
+
+// Add ...
+func (g *Git) Add(files ...string) error {
+	wt, err := g.worktree()
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		rel, err := g.rel(file)
+		if err != nil {
+			return err
+		}
+		switch {
+		case rel == ".":
+			err = wt.AddWithOptions(&gogit.AddOptions{All: true})
+		case fsutil.IsFile(filepath.Join(g.path, rel)) || fsutil.IsDir(filepath.Join(g.path, rel)):
+			_, err = wt.Add(rel)
+		}
+		if err != nil {
+			return fmt.Errorf("не удалось добавить файлы в git: %v", err)
+		}
+	}
+
+	// go-git only stages files it finds on disk, deletions inside of
+	// folders need to be staged explicitly
+	st, err := wt.Status()
+	if err != nil {
+		return err
+	}
+	for path, fs := range st {
+		if fs.Worktree != gogit.Deleted || !g.covered(path, files) {
+			continue
+		}
+		if _, err := wt.Remove(path); err != nil {
+			return fmt.Errorf("не удалось удалить %s из git: %v", path, err)
+		}
+	}
+
+	return nil
+}
+
+// Commit ...
+func (g *Git) Commit(msg string) error {
+	opts := &gogit.CommitOptions{}
+	if v, _ := g.ConfigGet("commit.gpgsign"); v == "true" {
+		if g.SignKey == nil && g.fallback != nil {
+			return g.fallback.Commit(msg)
+		}
+		key, err := g.signKey()
+		if err != nil {
+			return err
+		}
+		opts.SignKey = key
+	}
+
+	wt, err := g.worktree()
+	if err != nil {
+		return err
+	}
+
+	st, err := wt.Status()
+	if err != nil {
+		return err
+	}
+	staged := false
+	for _, fs := range st {
+		if fs.Staging != gogit.Unmodified && fs.Staging != gogit.Untracked {
+			staged = true
+			break
+		}
+	}
+	if !staged {
+		return git.ErrNothingToCommit
+	}
+
+	if _, err := wt.Commit(msg, opts); err != nil {
+		return fmt.Errorf("не удалось зафиксировать изменения в git: %v", err)
+	}
+	return nil
+}
+
+// CanSign reports whether commits can be signed, either in process or by
+// the git binary
+func (g *Git) CanSign() bool {
+	return g.SignKey != nil || g.fallback != nil
+}
+
+// signKey returns the key for user.signingkey, falling back to user.email
+// like git does
+func (g *Git) signKey() (*openpgp.Entity, error) {
+	id, _ := g.ConfigGet("user.signingkey")
+	if id == "" {
+		id, _ = g.ConfigGet("user.email")
+	}
+	if g.SignKey == nil {
+		return nil, fmt.Errorf("commit.gpgsign включен, но этот бэкенд шифрования не может подписывать коммиты без git")
+	}
+	key, err := g.SignKey(id)
+	if err != nil {
+		return nil, fmt.Errorf("не удалось получить ключ %s для подписи коммита: %v", id, err)
+	}
+	return key, nil
+}
+
+// ConfigGet ...
+func (g *Git) ConfigGet(key string) (string, error) {
+	r, err := g.open()
+	if err != nil {
+		return "", err
+	}
+	cfg, err := r.ConfigScoped(config.SystemScope)
+	if err != nil {
+		return "", err
+	}
+
+	section, sub, name, err := splitKey(key)
+	if err != nil {
+		return "", err
+	}
+
+	// remotes are not kept in the raw config after merging the scopes
+	if section == "remote" && name == "url" {
+		if rc, found := cfg.Remotes[sub]; found && len(rc.URLs) > 0 {
+			return rc.URLs[0], nil
+		}
+		return "", fmt.Errorf("удаленный репозиторий %s не найден", sub)
+	}
+
+	s := cfg.Raw.Section(section)
+	if sub != "" {
+		if !s.HasSubsection(sub) {
+			return "", fmt.Errorf("ключ %s не найден", key)
+		}
+		return s.Subsection(sub).Option(name), nil
+	}
+	if !s.HasOption(name) {
+		return "", fmt.Errorf("ключ %s не найден", key)
+	}
+	return s.Option(name), nil
+}
+
+// ConfigSet ...
+func (g *Git) ConfigSet(key, value string) error {
+	r, err := g.open()
+	if err != nil {
+		return err
+	}
+	cfg, err := r.Config()
+	if err != nil {
+		return err
+	}
+
+	section, sub, name, err := splitKey(key)
+	if err != nil {
+		return err
+	}
+	if sub != "" {
+		cfg.Raw.Section(section).Subsection(sub).SetOption(name, value)
+	} else {
+		cfg.Raw.Section(section).SetOption(name, value)
+	}
+	return r.SetConfig(cfg)
+}
+
+// Push ...
+func (g *Git) Push(remote, branch string) error {
+	r, err := g.open()
+	if err != nil {
+		return err
+	}
+	auth, err := g.auth(remote)
+	if err != nil {
+		return err
+	}
+
+	ref := "refs/heads/" + branch
+	err = r.Push(&gogit.PushOptions{
+		RemoteName: remote,
+		RefSpecs:   []config.RefSpec{config.RefSpec(ref + ":" + ref)},
+		Auth:       auth,
+		Progress:   os.Stdout,
+	})
+	switch {
+	case err == gogit.NoErrAlreadyUpToDate:
+		return nil
+	default:
+		return err
+	}
+}
+
+// Pull fast-forwards branch. go-git can not merge, so diverged branches
+// are merged or rebased by the fallback and fail without one
+func (g *Git) Pull(remote, branch string, rebase bool) error {
+	wt, err := g.worktree()
+	if err != nil {
+		return err
+	}
+	auth, err := g.auth(remote)
+	if err != nil {
+		return err
+	}
+
+	err = wt.Pull(&gogit.PullOptions{
+		RemoteName:    remote,
+		ReferenceName: plumbing.NewBranchReferenceName(branch),
+		Auth:          auth,
+		Progress:      os.Stdout,
+	})
+	switch {
+	case err == nil, err == gogit.NoErrAlreadyUpToDate, err == transport.ErrEmptyRemoteRepository:
+		return nil
+	case err == plumbing.ErrReferenceNotFound:
+		// the branch was not pushed yet
+		return nil
+	case err == gogit.ErrNonFastForwardUpdate && g.fallback != nil:
+		// go-git can only fast-forward
+		return g.fallback.Pull(remote, branch, rebase)
+	case err == gogit.ErrNonFastForwardUpdate:
+		return fmt.Errorf("ветки разошлись, для их слияния нужен установленный git")
+	default:
+		return err
+	}
+}
+
+// Status ...
+func (g *Git) Status() ([]string, error) {
+	wt, err := g.worktree()
+	if err != nil {
+		return nil, err
+	}
+	st, err := wt.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]string, 0, len(st))
+	for path, fs := range st {
+		if fs.Staging == gogit.Unmodified && fs.Worktree == gogit.Unmodified {
+			continue
+		}
+		lines = append(lines, fmt.Sprintf("%c%c %s", fs.Staging, fs.Worktree, path))
+	}
+	sort.Strings(lines)
+	return lines, nil
+}
+
+// Head ...
+func (g *Git) Head() (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r, err := g.open()
+	if err != nil {
+		return "", err
+	}
+	head, err := r.Head()
+	if err != nil {
+		return "", err
+	}
+	return head.Hash().String(), nil
+}
+
+// Branch ...
+func (g *Git) Branch() (string, error) {
+	r, err := g.open()
+	if err != nil {
+		return "", err
+	}
+	head, err := r.Head()
+	if err != nil {
+		return "", err
+	}
+	return head.Name().Short(), nil
+}
+
+// Log ...
+func (g *Git) Log(path string) ([]git.Commit, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	r, err := g.open()
+	if err != nil {
+		return nil, err
+	}
+	rel, err := g.rel(path)
+	if err != nil {
+		return nil, err
+	}
+
+	iter, err := r.Log(&gogit.LogOptions{FileName: &rel})
+	if err != nil {
+		if err == plumbing.ErrReferenceNotFound {
+			return []git.Commit{}, nil
+		}
+		return nil, err
+	}
+	defer iter.Close()
+
+	commits := make([]git.Commit, 0, 10)
+	err = iter.ForEach(func(c *object.Commit) error {
+		commits = append(commits, git.Commit{
+			Hash:    c.Hash.String(),
+			Author:  fmt.Sprintf("%s <%s>", c.Author.Name, c.Author.Email),
+			Date:    c.Author.When,
+			Message: strings.SplitN(strings.TrimSpace(c.Message), "\n", 2)[0],
+		})
+		return nil
+	})
+	return commits, err
+}
+
+// Show ...
+func (g *Git) Show(rev, path string) ([]byte, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	c, err := g.commit(rev)
+	if err != nil {
+		return nil, err
+	}
+	rel, err := g.rel(path)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := c.File(rel)
+	if err != nil {
+		return nil, err
+	}
+	rd, err := f.Reader()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = rd.Close()
+	}()
+
+	return ioutil.ReadAll(rd)
+}
+
+// Changes ...
+func (g *Git) Changes(from, to string) ([]git.Change, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	trees := make([]*object.Tree, 0, 2)
+	for _, rev := range []string{from, to} {
+		c, err := g.commit(rev)
+		if err != nil {
+			return nil, err
+		}
+		t, err := c.Tree()
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, t)
+	}
+
+	diff, err := object.DiffTree(trees[0], trees[1])
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]git.Change, 0, len(diff))
+	for _, d := range diff {
+		switch {
+		case d.From.Name == "":
+			changes = append(changes, git.Change{Op: 'A', Path: d.To.Name})
+		case d.To.Name == "":
+			changes = append(changes, git.Change{Op: 'D', Path: d.From.Name})
+		default:
+			changes = append(changes, git.Change{Op: 'M', Path: d.To.Name})
+		}
+	}
+	return changes, nil
+}
+
+func (g *Git) open() (*gogit.Repository, error) {
+	if g.repo != nil {
+		return g.repo, nil
+	}
+	r, err := gogit.PlainOpen(g.path)
+	if err != nil {
+		return nil, err
+	}
+	g.repo = r
+	return r, nil
+}
+
+func (g *Git) worktree() (*gogit.Worktree, error) {
+	r, err := g.open()
+	if err != nil {
+		return nil, err
+	}
+	return r.Worktree()
+}
+
+func (g *Git) commit(rev string) (*object.Commit, error) {
+	r, err := g.open()
+	if err != nil {
+		return nil, err
+	}
+	h, err := r.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return nil, fmt.Errorf("неизвестная ревизия %s: %s", rev, err)
+	}
+	return r.CommitObject(*h)
+}
+
+// rel returns path relative to the working tree with forward slashes
+func (g *Git) rel(path string) (string, error) {
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(g.path, path)
+	}
+	rel, err := filepath.Rel(g.path, path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+func (g *Git) covered(path string, files []string) bool {
+	for _, file := range files {
+		rel, err := g.rel(file)
+		if err != nil {
+			continue
+		}
+		if rel == "." || rel == path || strings.HasPrefix(path, rel+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *Git) auth(remote string) (transport.AuthMethod, error) {
+	url, err := g.ConfigGet("remote." + remote + ".url")
+	if err != nil {
+		return nil, err
+	}
+	return authFor(url)
+}
+
+// authFor uses the ssh agent or an unencrypted default key for ssh remotes
+func authFor(url string) (transport.AuthMethod, error) {
+	ep, err := transport.NewEndpoint(url)
+	if err != nil {
+		return nil, err
+	}
+	if ep.Protocol != "ssh" {
+		return nil, nil
+	}
+
+	user := ep.User
+	if user == "" {
+		user = "git"
+	}
+
+	if os.Getenv("SSH_AUTH_SOCK") != "" {
+		if a, err := ssh.NewSSHAgentAuth(user); err == nil {
+			return a, nil
+		}
+	}
+
+	for _, name := range []string{"id_ed25519", "id_ecdsa", "id_rsa"} {
+		fn, err := homedir.Expand(filepath.Join("~", ".ssh", name))
+		if err != nil || !fsutil.IsFile(fn) {
+			continue
+		}
+		if a, err := ssh.NewPublicKeysFromFile(user, fn, ""); err == nil {
+			return a, nil
+		}
+	}
+
+	return nil, fmt.Errorf("нет ssh агента или ключа без пароля для %s", url)
+}
+
+func splitKey(key string) (string, string, string, error) {
+	parts := strings.Split(key, ".")
+	switch {
+	case len(parts) == 2:
+		return parts[0], "", parts[1], nil
+	case len(parts) > 2:
+		return parts[0], strings.Join(parts[1:len(parts)-1], "."), parts[len(parts)-1], nil
+	default:
+		return "", "", "", fmt.Errorf("неверный ключ конфигурации %s", key)
+	}
+}
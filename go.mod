@@ -3,13 +3,18 @@ module github.com/ebladrocher/keypass
 go 1.15
 
 require (
+	filippo.io/age v1.0.0
+	github.com/ProtonMail/go-crypto v0.0.0-20210512092938-c05353c2d58c
 	github.com/atotto/clipboard v0.1.2
 	github.com/fatih/color v1.10.0
 	github.com/ghodss/yaml v1.0.0
+	github.com/go-git/go-billy/v5 v5.3.1
+	github.com/go-git/go-git/v5 v5.4.2
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/pkg/errors v0.9.1
 	github.com/urfave/cli/v2 v2.3.0
-	golang.org/x/crypto v0.0.0-20201221181555-eec23a3978ad
-	golang.org/x/net v0.0.0-20210119194325-5f4716e94777
-	golang.org/x/sys v0.0.0-20210105210732-16f7687f5001
+	golang.org/x/crypto v0.0.0-20210817164053-32db794688a5
+	golang.org/x/net v0.0.0-20210326060303-6b1517762897
+	golang.org/x/sys v0.0.0-20210903071746-97244b99971b
+	gopkg.in/yaml.v3 v3.0.1
 )
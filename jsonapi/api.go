@@ -1,6 +1,7 @@
 package jsonapi
 
 import (
+	"bufio"
 	"context"
 	"io"
 
@@ -12,11 +13,12 @@ type API struct {
 	Store  *storepass.RootStore
 	Reader io.Reader
 	Writer io.Writer
+	reader *bufio.Reader
 }
 
 // ReadAndRespond ...
 func (api *API) ReadAndRespond(ctx context.Context) error {
-	message, err := readMessage(api.Reader)
+	message, err := api.next()
 	if message == nil || err != nil {
 		return err
 	}
@@ -24,6 +26,45 @@ func (api *API) ReadAndRespond(ctx context.Context) error {
 	return api.respondMessage(ctx, message)
 }
 
+// Listen responds to messages until the reader is closed or ctx is
+// canceled. A message that fails gets an error response and does not end
+// the session, only a broken stream does
+func (api *API) Listen(ctx context.Context) error {
+	type result struct {
+		message []byte
+		err     error
+	}
+
+	for {
+		// reading blocks, so it can not be interrupted otherwise
+		ch := make(chan result, 1)
+		go func() {
+			message, err := api.next()
+			ch <- result{message: message, err: err}
+		}()
+
+		var res result
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case res = <-ch:
+		}
+
+		if res.err != nil {
+			return res.err
+		}
+		if res.message == nil {
+			return nil
+		}
+
+		if err := api.respondMessage(ctx, res.message); err != nil {
+			if err := api.RespondError(err); err != nil {
+				return err
+			}
+		}
+	}
+}
+
 // RespondError ...
 func (api *API) RespondError(err error) error {
 	var response errorResponse
@@ -31,3 +72,12 @@ func (api *API) RespondError(err error) error {
 
 	return sendSerializedJSONMessage(response, api.Writer)
 }
+
+// next reads the next message. The buffered reader is kept, as it may
+// already hold the start of the following message
+func (api *API) next() ([]byte, error) {
+	if api.reader == nil {
+		api.reader = bufio.NewReader(api.Reader)
+	}
+	return readMessage(api.reader)
+}
@@ -0,0 +1,206 @@
+package jsonapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ebladrocher/keypass/crypto/age"
+	"github.com/ebladrocher/keypass/storepass"
+)
+
+// session runs Listen on a store with a few secrets and talks to it
+// through pipes
+type session struct {
+	in     *io.PipeWriter
+	out    *io.PipeReader
+	cancel context.CancelFunc
+	done   chan error
+}
+
+func newSession(t *testing.T) *session {
+	dir, err := ioutil.TempDir("", "keypass-jsonapi-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+
+	c := age.New(filepath.Join(dir, "identities"))
+	path := filepath.Join(dir, "store")
+	for _, name := range []string{c.IDFile(), "github.com/alice" + c.Ext(), "example.org" + c.Ext()} {
+		fn := filepath.Join(path, name)
+		if err := os.MkdirAll(filepath.Dir(fn), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(fn, []byte{}, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	store, err := storepass.NewRootStore(path, c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inR, inW := io.Pipe()
+	outR, outW := io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &session{
+		in:     inW,
+		out:    outR,
+		cancel: cancel,
+		done:   make(chan error, 1),
+	}
+	t.Cleanup(func() {
+		cancel()
+		_ = inR.Close()
+		_ = outR.Close()
+	})
+
+	api := &API{Store: store, Reader: inR, Writer: outW}
+	go func() {
+		s.done <- api.Listen(ctx)
+	}()
+	return s
+}
+
+func frame(msg string) []byte {
+	buf := &bytes.Buffer{}
+	_ = binary.Write(buf, binary.LittleEndian, uint32(len(msg)))
+	buf.WriteString(msg)
+	return buf.Bytes()
+}
+
+// write is called from other goroutines, as the pipe blocks until Listen
+// reads, so it must not stop the test
+func (s *session) write(t *testing.T, buf []byte) {
+	if _, err := s.in.Write(buf); err != nil {
+		t.Errorf("write: %s", err)
+	}
+}
+
+func (s *session) read(t *testing.T, v interface{}) {
+	msg, err := readMessage(s.out)
+	if err != nil {
+		t.Fatalf("read response: %s", err)
+	}
+	if err := json.Unmarshal(msg, v); err != nil {
+		t.Fatalf("response %s: %s", msg, err)
+	}
+}
+
+func (s *session) wait(t *testing.T) error {
+	select {
+	case err := <-s.done:
+		return err
+	case <-time.After(5 * time.Second):
+		t.Fatal("Listen did not return")
+		return nil
+	}
+}
+
+func (s *session) query(t *testing.T, want ...string) {
+	var got []string
+	s.read(t, &got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("query response = %q, want %q", got, want)
+	}
+}
+
+func TestListenSeveralFrames(t *testing.T) {
+	s := newSession(t)
+
+	buf := append(frame(`{"type":"query","query":"github"}`), frame(`{"type":"query","query":"example"}`)...)
+	go s.write(t, buf)
+
+	s.query(t, "github.com/alice")
+	s.query(t, "example.org")
+
+	_ = s.in.Close()
+	if err := s.wait(t); err != nil {
+		t.Errorf("Listen = %s, want nil", err)
+	}
+}
+
+func TestListenSplitFrame(t *testing.T) {
+	s := newSession(t)
+
+	buf := frame(`{"type":"query","query":"alice"}`)
+	go func() {
+		for _, part := range [][]byte{buf[:2], buf[2:10], buf[10:]} {
+			s.write(t, part)
+		}
+	}()
+
+	s.query(t, "github.com/alice")
+
+	_ = s.in.Close()
+	if err := s.wait(t); err != nil {
+		t.Errorf("Listen = %s, want nil", err)
+	}
+}
+
+func TestListenBadMessage(t *testing.T) {
+	s := newSession(t)
+
+	go s.write(t, frame(`{"type":"bogus"}`))
+	var resp errorResponse
+	s.read(t, &resp)
+	if resp.Error == "" {
+		t.Error("no error response for an unknown message type")
+	}
+
+	go s.write(t, frame(`not json`))
+	resp = errorResponse{}
+	s.read(t, &resp)
+	if resp.Error == "" {
+		t.Error("no error response for invalid JSON")
+	}
+
+	go s.write(t, frame(`{"type":"query","query":"example"}`))
+	s.query(t, "example.org")
+
+	_ = s.in.Close()
+	if err := s.wait(t); err != nil {
+		t.Errorf("Listen = %s, want nil", err)
+	}
+}
+
+func TestListenEOF(t *testing.T) {
+	s := newSession(t)
+
+	_ = s.in.Close()
+	if err := s.wait(t); err != nil {
+		t.Errorf("Listen = %s, want nil", err)
+	}
+}
+
+func TestListenTruncatedFrame(t *testing.T) {
+	s := newSession(t)
+
+	go func() {
+		s.write(t, frame(`{"type":"query","query":"alice"}`)[:10])
+		_ = s.in.Close()
+	}()
+	if err := s.wait(t); err == nil {
+		t.Error("Listen = nil for a truncated frame, want an error")
+	}
+}
+
+func TestListenCancel(t *testing.T) {
+	s := newSession(t)
+
+	s.cancel()
+	if err := s.wait(t); err != context.Canceled {
+		t.Errorf("Listen = %v, want %v", err, context.Canceled)
+	}
+}
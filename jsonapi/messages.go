@@ -1,7 +1,6 @@
 package jsonapi
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/binary"
 	"fmt"
@@ -29,6 +28,16 @@ type loginResponse struct {
 	Password string `json:"password"`
 }
 
+type getOTPMessage struct {
+	Entry string `json:"entry"`
+}
+
+type otpResponse struct {
+	Code      string `json:"code"`
+	Type      string `json:"type"`
+	Remaining int    `json:"remaining,omitempty"`
+}
+
 type createEntryMessage struct {
 	Name           string `json:"entry_name"`
 	Login          string `json:"login"`
@@ -36,35 +45,43 @@ type createEntryMessage struct {
 	PasswordLength int    `json:"length"`
 	Generate       bool   `json:"generate"`
 	UseSymbols     bool   `json:"use_symbols"`
+	Generator      string `json:"generator"`
+	Separator      string `json:"separator"`
 }
 
 type errorResponse struct {
 	Error string `json:"error"`
 }
 
+// maxMessageSize guards against allocating a huge buffer for a corrupt
+// length prefix
+const maxMessageSize = 8 * 1024 * 1024
+
+// readMessage reads one length prefixed message. It returns nil without an
+// error if the reader is closed before a new message starts
 func readMessage(r io.Reader) ([]byte, error) {
-	stdin := bufio.NewReader(r)
 	lenBytes := make([]byte, 4)
-	count, err := stdin.Read(lenBytes)
-	if err != nil {
+	if _, err := io.ReadFull(r, lenBytes); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("недостаточно прочитано байтов, чтобы определить размер сообщения")
+		}
 		return nil, eofReturn(err)
 	}
-	if count != 4 {
-		return nil, fmt.Errorf("недостаточно прочитано байтов, чтобы определить размер сообщения")
-	}
 
 	length, err := getMessageLength(lenBytes)
 	if err != nil {
 		return nil, err
 	}
+	if length > maxMessageSize {
+		return nil, fmt.Errorf("сообщение слишком большое: %d байт", length)
+	}
 
 	msgBytes := make([]byte, length)
-	count, err = stdin.Read(msgBytes)
-	if err != nil {
-		return nil, eofReturn(err)
-	}
-	if count != length {
-		return nil, fmt.Errorf("сообщение прочитано не польностью")
+	if _, err := io.ReadFull(r, msgBytes); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("сообщение прочитано не польностью")
+		}
+		return nil, err
 	}
 
 	return msgBytes, nil
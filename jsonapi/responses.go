@@ -10,8 +10,11 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"time"
 
+	"github.com/ebladrocher/keypass/otp"
 	"github.com/ebladrocher/keypass/pass"
+	"github.com/ebladrocher/keypass/secret"
 	"github.com/pkg/errors"
 )
 
@@ -34,6 +37,8 @@ func (api *API) respondMessage(ctx context.Context, msgBytes []byte) error {
 		return api.respondGetLogin(ctx, msgBytes)
 	case "create":
 		return api.respondCreateEntry(ctx, msgBytes)
+	case "otp":
+		return api.respondOTP(ctx, msgBytes)
 	default:
 		return fmt.Errorf("Сообщение неизвестного типа %s", message.Type)
 	}
@@ -93,18 +98,60 @@ func (api *API) respondGetLogin(ctx context.Context, msgBytes []byte) error {
 		return errors.Wrapf(err, "не удалось десериализовать сообщение JSON")
 	}
 
-	sec, err := api.Store.Get(message.Entry)
+	content, err := api.Store.Get(message.Entry)
 	if err != nil {
 		return errors.Wrapf(err, "не удалось получить секрет")
 	}
+	sec := secret.Parse(content)
 
 	return sendSerializedJSONMessage(loginResponse{
-		Username: api.getUsername(message.Entry),
-		Password: string(sec),
+		Username: api.getUsername(message.Entry, sec),
+		Password: sec.Password(),
 	}, api.Writer)
 }
 
-func (api *API) getUsername(name string) string {
+func (api *API) respondOTP(ctx context.Context, msgBytes []byte) error {
+	var message getOTPMessage
+	if err := json.Unmarshal(msgBytes, &message); err != nil {
+		return errors.Wrapf(err, "не удалось десериализовать сообщение JSON")
+	}
+
+	content, err := api.Store.Get(message.Entry)
+	if err != nil {
+		return errors.Wrapf(err, "не удалось получить секрет")
+	}
+
+	k, err := otp.FromContent(content)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	code, err := k.Code(now)
+	if err != nil {
+		return err
+	}
+
+	if k.Type == otp.HOTP {
+		if err := api.Store.SetConfirm(message.Entry, k.Increment(content), api.confirmRecipients); err != nil {
+			return errors.Wrapf(err, "не удалось сохранить счетчик HOTP")
+		}
+	}
+
+	return sendSerializedJSONMessage(otpResponse{
+		Code:      code,
+		Type:      k.Type,
+		Remaining: k.Remaining(now),
+	}, api.Writer)
+}
+
+func (api *API) getUsername(name string, sec *secret.Secret) string {
+	for _, key := range []string{"login", "user", "username"} {
+		if v, found := sec.Get(key); found && v != "" {
+			return v
+		}
+	}
+
 	// if no meta-data was found return the name of the secret itself
 	// as the username, e.g. providers/amazon.com/foobar -> foobar
 	if strings.Contains(name, sep) {
@@ -160,6 +207,27 @@ func writeMessageLength(msg []byte, w io.Writer) error {
 	return binary.Write(w, binary.LittleEndian, uint32(len(msg)))
 }
 
+func generate(message createEntryMessage) ([]byte, error) {
+	switch message.Generator {
+	case "", "chars":
+		return pass.GeneratePassword(message.PasswordLength, message.UseSymbols), nil
+	case "words":
+		sep, n := message.Separator, message.PasswordLength
+		if sep == "" {
+			sep = "-"
+		}
+		if n < 1 {
+			n = 6
+		}
+		return pass.GeneratePassphrase(pass.WordOptions{
+			Words:     n,
+			Separator: sep,
+		}), nil
+	default:
+		return nil, fmt.Errorf("неизвестный генератор %s", message.Generator)
+	}
+}
+
 func (api *API) respondCreateEntry(ctx context.Context, msgBytes []byte) error {
 	var message createEntryMessage
 	if err := json.Unmarshal(msgBytes, &message); err != nil {
@@ -175,14 +243,19 @@ func (api *API) respondCreateEntry(ctx context.Context, msgBytes []byte) error {
 	}
 
 	if message.Generate {
-		str := pass.GeneratePassword(message.PasswordLength, message.UseSymbols)
+		str, err := generate(message)
 		if err != nil {
-			return fmt.Errorf("ERROR %s", err)
+			return err
 		}
 		message.Password = string(str)
 	}
 
-	if err := api.Store.SetConfirm(message.Name, []byte(message.Password), api.confirmRecipients); err != nil {
+	sec := secret.New(message.Password)
+	if message.Login != "" {
+		sec.Set("login", message.Login)
+	}
+
+	if err := api.Store.SetConfirm(message.Name, sec.Bytes(), api.confirmRecipients); err != nil {
 		return errors.Wrapf(err, "failed to store secret")
 	}
 
@@ -0,0 +1,216 @@
+package otp
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ebladrocher/keypass/secret"
+)
+
+const (
+	// TOTP ...
+	TOTP = "totp"
+	// HOTP ...
+	HOTP = "hotp"
+
+	uriPrefix = "otpauth://"
+)
+
+var (
+	// ErrNoOTP ...
+	ErrNoOTP = fmt.Errorf("секрет не содержит otpauth:// URI или поле totp")
+)
+
+// Key ...
+type Key struct {
+	Type      string
+	Secret    []byte
+	Algorithm string
+	Digits    int
+	Period    int
+	Counter   uint64
+	Label     string
+	Issuer    string
+
+	uri string
+}
+
+// FromContent finds an otpauth:// URI or a `totp:` field in the secret
+func FromContent(content []byte) (*Key, error) {
+	sec := secret.Parse(content)
+
+	lines := append([]string{sec.Password()}, strings.Split(sec.Body(), "\n")...)
+	for _, line := range lines {
+		line = strings.TrimSpace(line)
+		if i := strings.Index(line, uriPrefix); i >= 0 {
+			return Parse(line[i:])
+		}
+	}
+
+	if v, found := sec.Get("totp"); found && v != "" {
+		if strings.HasPrefix(v, uriPrefix) {
+			return Parse(v)
+		}
+		return NewTOTP(v)
+	}
+
+	return nil, ErrNoOTP
+}
+
+// NewTOTP creates a key with the default parameters for a base32 seed
+func NewTOTP(seed string) (*Key, error) {
+	sk, err := decodeSecret(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Key{
+		Type:      TOTP,
+		Secret:    sk,
+		Algorithm: "SHA1",
+		Digits:    6,
+		Period:    30,
+	}, nil
+}
+
+// Parse parses an otpauth:// URI
+func Parse(uri string) (*Key, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("неверный otpauth URI: %s", err)
+	}
+	if u.Scheme != "otpauth" {
+		return nil, fmt.Errorf("неверная схема %s", u.Scheme)
+	}
+
+	q := u.Query()
+	k := &Key{
+		Type:      strings.ToLower(u.Host),
+		Algorithm: "SHA1",
+		Digits:    6,
+		Period:    30,
+		Label:     strings.TrimPrefix(u.Path, "/"),
+		Issuer:    q.Get("issuer"),
+		uri:       uri,
+	}
+	if k.Type != TOTP && k.Type != HOTP {
+		return nil, fmt.Errorf("неизвестный тип OTP %s", u.Host)
+	}
+
+	if k.Secret, err = decodeSecret(q.Get("secret")); err != nil {
+		return nil, err
+	}
+	if v := q.Get("algorithm"); v != "" {
+		k.Algorithm = strings.ToUpper(v)
+	}
+	if v := q.Get("digits"); v != "" {
+		if k.Digits, err = strconv.Atoi(v); err != nil || k.Digits < 1 || k.Digits > 10 {
+			return nil, fmt.Errorf("неверное число цифр %s", v)
+		}
+	}
+	if v := q.Get("period"); v != "" {
+		if k.Period, err = strconv.Atoi(v); err != nil || k.Period < 1 {
+			return nil, fmt.Errorf("неверный период %s", v)
+		}
+	}
+	if v := q.Get("counter"); v != "" {
+		if k.Counter, err = strconv.ParseUint(v, 10, 64); err != nil {
+			return nil, fmt.Errorf("неверный счетчик %s", v)
+		}
+	}
+
+	return k, nil
+}
+
+// Code returns the code for t (TOTP) or the current counter (HOTP)
+func (k *Key) Code(t time.Time) (string, error) {
+	counter := k.Counter
+	if k.Type == TOTP {
+		counter = uint64(t.Unix()) / uint64(k.Period)
+	}
+	return Generate(k.Secret, counter, k.Digits, k.Algorithm)
+}
+
+// Remaining returns the seconds the TOTP code for t is still valid
+func (k *Key) Remaining(t time.Time) int {
+	if k.Type != TOTP {
+		return 0
+	}
+	return k.Period - int(t.Unix()%int64(k.Period))
+}
+
+// Increment advances the HOTP counter and returns content with the
+// updated URI
+func (k *Key) Increment(content []byte) []byte {
+	if k.Type != HOTP || k.uri == "" {
+		return content
+	}
+
+	k.Counter++
+	u, err := url.Parse(k.uri)
+	if err != nil {
+		return content
+	}
+	q := u.Query()
+	q.Set("counter", strconv.FormatUint(k.Counter, 10))
+	u.RawQuery = q.Encode()
+
+	nuri := u.String()
+	content = bytes.Replace(content, []byte(k.uri), []byte(nuri), 1)
+	k.uri = nuri
+	return content
+}
+
+// Generate computes an RFC 4226 code
+func Generate(key []byte, counter uint64, digits int, algorithm string) (string, error) {
+	var h func() hash.Hash
+	switch strings.ToUpper(algorithm) {
+	case "", "SHA1":
+		h = sha1.New
+	case "SHA256":
+		h = sha256.New
+	case "SHA512":
+		h = sha512.New
+	default:
+		return "", fmt.Errorf("неизвестный алгоритм %s", algorithm)
+	}
+
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, counter)
+
+	mac := hmac.New(h, key)
+	_, _ = mac.Write(msg)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := int64(binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff)
+
+	mod := int64(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod), nil
+}
+
+func decodeSecret(seed string) ([]byte, error) {
+	seed = strings.ToUpper(strings.Replace(strings.TrimSpace(seed), " ", "", -1))
+	seed = strings.TrimRight(seed, "=")
+	if seed == "" {
+		return nil, fmt.Errorf("пустой секрет OTP")
+	}
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("неверный секрет OTP: %s", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,39 @@
+package pass
+
+import "math"
+
+// RecommendedEntropy is the minimum entropy in bits we expect from
+// generated secrets
+const RecommendedEntropy = 80
+
+// CharsetEntropy returns the entropy of a random password of length
+// characters drawn from chars
+func CharsetEntropy(length int, chars string) float64 {
+	return float64(length) * log2(len(unique(chars)))
+}
+
+// PassphraseEntropy ...
+func PassphraseEntropy(o WordOptions) float64 {
+	words := o.Words
+	if words < 1 {
+		words = 1
+	}
+	bits := float64(words) * log2(len(wordList()))
+	if o.Digit {
+		bits += log2(len(numbers)) + log2(words)
+	}
+	return bits
+}
+
+// Entropy returns the estimated entropy of a password generated by the
+// policy
+func (p Policy) Entropy(length int, chars string) float64 {
+	return CharsetEntropy(p.Length(length), p.pool(chars))
+}
+
+func log2(n int) float64 {
+	if n < 1 {
+		return 0
+	}
+	return math.Log2(float64(n))
+}
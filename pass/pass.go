@@ -24,14 +24,7 @@ func init() {
 
 // GeneratePassword ....
 func GeneratePassword(length int, symbols bool) []byte {
-	chars := numbers + upper + lower
-	if symbols {
-		chars += syms
-	}
-	if c := os.Getenv("KEYPASS_CHARACTER_SET"); c != "" {
-		chars = c
-	}
-	return []byte(GeneratePasswordCharset(length, chars))
+	return []byte(GeneratePasswordCharset(length, Charset(symbols)))
 }
 
 // GeneratePasswordCharset ...
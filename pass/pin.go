@@ -0,0 +1,36 @@
+package pass
+
+// GeneratePIN returns a numeric PIN where no digit is followed by the same
+// or an adjacent digit, so there are no repeated or sequential runs like
+// 1111 or 1234
+func GeneratePIN(length int) []byte {
+	pin := make([]byte, 0, length)
+	for len(pin) < length {
+		candidates := numbers
+		if len(pin) > 0 {
+			candidates = pinCandidates(pin[len(pin)-1])
+		}
+		pin = append(pin, candidates[randomInteger(len(candidates))])
+	}
+	return pin
+}
+
+// PINEntropy returns the lower bound of the entropy of a PIN
+func PINEntropy(length int) float64 {
+	if length < 1 {
+		return 0
+	}
+	return log2(len(numbers)) + float64(length-1)*log2(len(numbers)-3)
+}
+
+func pinCandidates(prev byte) string {
+	out := make([]byte, 0, len(numbers))
+	for i := 0; i < len(numbers); i++ {
+		d := numbers[i]
+		if d == prev || d == prev+1 || d+1 == prev {
+			continue
+		}
+		out = append(out, d)
+	}
+	return string(out)
+}
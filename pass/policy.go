@@ -0,0 +1,266 @@
+package pass
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghodss/yaml"
+)
+
+const (
+	// PolicyFile ...
+	PolicyFile = ".keypass-policy"
+
+	maxAttempts = 1000
+)
+
+// Policy describes the rules a generated password must satisfy
+type Policy struct {
+	MinLength  int    `json:"min_length"`
+	MaxLength  int    `json:"max_length"`
+	MinUpper   int    `json:"min_upper"`
+	MinLower   int    `json:"min_lower"`
+	MinDigits  int    `json:"min_digits"`
+	MinSymbols int    `json:"min_symbols"`
+	Allowed    string `json:"allowed"`
+	Forbidden  string `json:"forbidden"`
+	MaxRepeat  int    `json:"max_repeat"`
+}
+
+// ParsePolicy parses a policy in YAML or JSON
+func ParsePolicy(data []byte) (Policy, error) {
+	var p Policy
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return p, fmt.Errorf("не удалось разобрать политику паролей: %s", err)
+	}
+	return p, p.Validate()
+}
+
+// Charset returns the default character set, honoring
+// KEYPASS_CHARACTER_SET
+func Charset(symbols bool) string {
+	chars := numbers + upper + lower
+	if symbols {
+		chars += syms
+	}
+	if c := os.Getenv("KEYPASS_CHARACTER_SET"); c != "" {
+		chars = c
+	}
+	return chars
+}
+
+// IsZero ...
+func (p Policy) IsZero() bool {
+	return p == Policy{}
+}
+
+// Validate ...
+func (p Policy) Validate() error {
+	if p.MinLength < 0 || p.MaxLength < 0 || p.MinUpper < 0 || p.MinLower < 0 ||
+		p.MinDigits < 0 || p.MinSymbols < 0 || p.MaxRepeat < 0 {
+		return fmt.Errorf("значения политики паролей не могут быть отрицательными")
+	}
+	if p.MaxLength > 0 && p.MinLength > p.MaxLength {
+		return fmt.Errorf("минимальная длина %d больше максимальной %d", p.MinLength, p.MaxLength)
+	}
+	if p.MaxLength > 0 && p.required() > p.MaxLength {
+		return fmt.Errorf("обязательных символов %d больше максимальной длины %d", p.required(), p.MaxLength)
+	}
+	for _, c := range p.classes() {
+		if c.min > 0 && c.chars == "" {
+			return fmt.Errorf("политика требует %s, но все они запрещены", c.name)
+		}
+	}
+	return nil
+}
+
+// Length clamps length into the allowed range
+func (p Policy) Length(length int) int {
+	if length < p.MinLength {
+		length = p.MinLength
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		length = p.MaxLength
+	}
+	if r := p.required(); length < r {
+		length = r
+	}
+	return length
+}
+
+// Check returns an error describing the first rule pw violates
+func (p Policy) Check(pw string) error {
+	if len(pw) < p.MinLength {
+		return fmt.Errorf("пароль короче %d символов", p.MinLength)
+	}
+	if p.MaxLength > 0 && len(pw) > p.MaxLength {
+		return fmt.Errorf("пароль длиннее %d символов", p.MaxLength)
+	}
+	for _, c := range p.classes() {
+		if n := countIn(pw, c.set); n < c.min {
+			return fmt.Errorf("пароль содержит %d из %d требуемых %s", n, c.min, c.name)
+		}
+	}
+	if i := strings.IndexAny(pw, p.Forbidden); i >= 0 {
+		return fmt.Errorf("пароль содержит запрещенный символ %q", pw[i])
+	}
+	if p.Allowed != "" {
+		for _, r := range pw {
+			if !strings.ContainsRune(p.Allowed, r) {
+				return fmt.Errorf("пароль содержит недопустимый символ %q", r)
+			}
+		}
+	}
+	if p.MaxRepeat > 0 {
+		run := 0
+		for i := range pw {
+			if i > 0 && pw[i] == pw[i-1] {
+				run++
+			} else {
+				run = 1
+			}
+			if run > p.MaxRepeat {
+				return fmt.Errorf("символ %q повторяется больше %d раз подряд", pw[i], p.MaxRepeat)
+			}
+		}
+	}
+	return nil
+}
+
+// Generate returns a password of the given length drawn from chars that
+// satisfies the policy
+func (p Policy) Generate(length int, chars string) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", err
+	}
+	length = p.Length(length)
+
+	pool := p.pool(chars)
+	if pool == "" {
+		return "", fmt.Errorf("политика паролей не оставляет допустимых символов")
+	}
+
+	for i := 0; i < maxAttempts; i++ {
+		pw := make([]byte, 0, length)
+		for _, c := range p.classes() {
+			for j := 0; j < c.min; j++ {
+				pw = append(pw, c.chars[randomInteger(len(c.chars))])
+			}
+		}
+		for len(pw) < length {
+			pw = append(pw, pool[randomInteger(len(pool))])
+		}
+		shuffle(pw)
+
+		if p.Check(string(pw)) == nil {
+			return string(pw), nil
+		}
+	}
+
+	return "", fmt.Errorf("не удалось сгенерировать пароль, удовлетворяющий политике")
+}
+
+// Merge returns p with every non-zero field of o applied
+func (p Policy) Merge(o Policy) Policy {
+	if o.MinLength > 0 {
+		p.MinLength = o.MinLength
+	}
+	if o.MaxLength > 0 {
+		p.MaxLength = o.MaxLength
+	}
+	if o.MinUpper > 0 {
+		p.MinUpper = o.MinUpper
+	}
+	if o.MinLower > 0 {
+		p.MinLower = o.MinLower
+	}
+	if o.MinDigits > 0 {
+		p.MinDigits = o.MinDigits
+	}
+	if o.MinSymbols > 0 {
+		p.MinSymbols = o.MinSymbols
+	}
+	if o.Allowed != "" {
+		p.Allowed = o.Allowed
+	}
+	if o.Forbidden != "" {
+		p.Forbidden = o.Forbidden
+	}
+	if o.MaxRepeat > 0 {
+		p.MaxRepeat = o.MaxRepeat
+	}
+	return p
+}
+
+type charClass struct {
+	name  string
+	set   string
+	chars string
+	min   int
+}
+
+func (p Policy) classes() []charClass {
+	return []charClass{
+		{name: "заглавных букв", set: upper, chars: p.filter(upper), min: p.MinUpper},
+		{name: "строчных букв", set: lower, chars: p.filter(lower), min: p.MinLower},
+		{name: "цифр", set: numbers, chars: p.filter(numbers), min: p.MinDigits},
+		{name: "символов", set: syms, chars: p.filter(syms), min: p.MinSymbols},
+	}
+}
+
+// filter removes every character not allowed by the policy
+func (p Policy) filter(chars string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(p.Forbidden, r) {
+			return -1
+		}
+		if p.Allowed != "" && !strings.ContainsRune(p.Allowed, r) {
+			return -1
+		}
+		return r
+	}, chars)
+}
+
+// pool returns all characters a generated password can contain
+func (p Policy) pool(chars string) string {
+	pool := p.filter(chars)
+	for _, c := range p.classes() {
+		if c.min > 0 {
+			pool += c.chars
+		}
+	}
+	return unique(pool)
+}
+
+func (p Policy) required() int {
+	return p.MinUpper + p.MinLower + p.MinDigits + p.MinSymbols
+}
+
+func countIn(s, set string) int {
+	n := 0
+	for _, r := range s {
+		if strings.ContainsRune(set, r) {
+			n++
+		}
+	}
+	return n
+}
+
+func unique(s string) string {
+	seen := make(map[rune]bool, len(s))
+	return strings.Map(func(r rune) rune {
+		if seen[r] {
+			return -1
+		}
+		seen[r] = true
+		return r
+	}, s)
+}
+
+func shuffle(b []byte) {
+	for i := len(b) - 1; i > 0; i-- {
+		j := randomInteger(i + 1)
+		b[i], b[j] = b[j], b[i]
+	}
+}
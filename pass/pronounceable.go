@@ -0,0 +1,28 @@
+package pass
+
+import "bytes"
+
+const (
+	consonants = "bcdfghjklmnprstvwz"
+	vowels     = "aeiou"
+)
+
+// GeneratePronounceable returns a lower case password of alternating
+// consonants and vowels, e.g. "bakitoruse"
+func GeneratePronounceable(length int) []byte {
+	pw := &bytes.Buffer{}
+	for pw.Len() < length {
+		_ = pw.WriteByte(consonants[randomInteger(len(consonants))])
+		if pw.Len() < length {
+			_ = pw.WriteByte(vowels[randomInteger(len(vowels))])
+		}
+	}
+	return pw.Bytes()
+}
+
+// PronounceableEntropy ...
+func PronounceableEntropy(length int) float64 {
+	c := (length + 1) / 2
+	v := length / 2
+	return float64(c)*log2(len(consonants)) + float64(v)*log2(len(vowels))
+}
@@ -0,0 +1,269 @@
+package pass
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// Strength is the estimated resistance of a password against guessing
+type Strength struct {
+	// Score ranges from 0 (trivial) to 4 (very strong)
+	Score int
+	// Guesses is the log10 of the estimated number of guesses
+	Guesses float64
+	// Warning explains the weakest part of the password
+	Warning string
+}
+
+// Bits returns the estimated entropy in bits
+func (s Strength) Bits() float64 {
+	return s.Guesses * math.Log2(10)
+}
+
+type match struct {
+	start, end int
+	guesses    float64
+	warning    string
+}
+
+var (
+	keyboardRows = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm", "йцукенгшщзхъ", "фывапролджэ", "ячсмитьбю"}
+	leet         = strings.NewReplacer("4", "a", "@", "a", "3", "e", "1", "i", "!", "i", "0", "o", "$", "s", "5", "s", "7", "t", "+", "t")
+
+	dictOnce sync.Once
+	dict     map[string]float64
+)
+
+// commonPasswords are among the most frequently leaked passwords, ordered
+// by popularity
+var commonPasswords = []string{
+	"123456", "password", "12345678", "qwerty", "123456789", "12345", "1234", "111111",
+	"1234567", "dragon", "123123", "baseball", "abc123", "football", "monkey", "letmein",
+	"696969", "shadow", "master", "666666", "qwertyuiop", "123321", "mustang", "1234567890",
+	"michael", "654321", "superman", "1qaz2wsx", "7777777", "121212", "000000", "qazwsx",
+	"123qwe", "killer", "trustno1", "jordan", "jennifer", "zxcvbnm", "asdfgh", "hunter",
+	"buster", "soccer", "harley", "batman", "andrew", "tigger", "sunshine", "iloveyou",
+	"2000", "charlie", "robert", "thomas", "hockey", "ranger", "daniel", "starwars",
+	"klaster", "112233", "george", "computer", "michelle", "jessica", "pepper", "1111",
+	"zxcvbn", "555555", "11111111", "131313", "freedom", "777777", "pass", "maggie",
+	"159753", "aaaaaa", "ginger", "princess", "joshua", "cheese", "amanda", "summer",
+	"love", "ashley", "nicole", "chelsea", "biteme", "matthew", "access", "yankees",
+	"987654321", "dallas", "austin", "thunder", "taylor", "matrix", "admin", "welcome",
+	"login", "passw0rd", "password1", "qwerty123", "secret", "root", "changeme", "default",
+	"parol", "privet", "qwe123", "zaq12wsx", "1q2w3e4r", "1q2w3e", "123qweasd", "marina",
+}
+
+func dictionary() map[string]float64 {
+	dictOnce.Do(func() {
+		dict = make(map[string]float64, len(commonPasswords)+len(wordList()))
+		for _, w := range wordList() {
+			if len(w) > 2 {
+				dict[w] = float64(len(commonPasswords) + len(wordList()))
+			}
+		}
+		for i, w := range commonPasswords {
+			dict[w] = float64(i + 1)
+		}
+	})
+	return dict
+}
+
+// EstimateStrength estimates the number of guesses an attacker needs for
+// pw. Like zxcvbn it finds the cheapest split of the password into
+// dictionary words, repeats, sequences, keyboard patterns, years and
+// random characters
+func EstimateStrength(pw string) Strength {
+	runes := []rune(pw)
+	n := len(runes)
+	if n == 0 {
+		return Strength{Warning: "пустой пароль"}
+	}
+
+	matches := findMatches(runes)
+
+	// best[i] is the log10 of the guesses for runes[:i]
+	best := make([]float64, n+1)
+	via := make([]*match, n+1)
+	for i := 1; i <= n; i++ {
+		best[i] = best[i-1] + math.Log10(cardinality(runes[i-1]))
+		via[i] = nil
+		for k := range matches {
+			m := &matches[k]
+			if m.end != i {
+				continue
+			}
+			if g := best[m.start] + math.Log10(m.guesses); g < best[i] {
+				best[i] = g
+				via[i] = m
+			}
+		}
+	}
+
+	s := Strength{Guesses: best[n]}
+	switch {
+	case s.Guesses < 3:
+		s.Score = 0
+	case s.Guesses < 6:
+		s.Score = 1
+	case s.Guesses < 8:
+		s.Score = 2
+	case s.Guesses < 10:
+		s.Score = 3
+	default:
+		s.Score = 4
+	}
+
+	if s.Score >= 3 {
+		return s
+	}
+
+	// report the pattern covering most of the password
+	longest := 0
+	for i := n; i > 0; {
+		m := via[i]
+		if m == nil {
+			i--
+			continue
+		}
+		if l := m.end - m.start; l > longest {
+			longest = l
+			s.Warning = m.warning
+		}
+		i = m.start
+	}
+	if s.Warning == "" && n < 8 {
+		s.Warning = "слишком короткий пароль"
+	}
+
+	return s
+}
+
+func findMatches(runes []rune) []match {
+	n := len(runes)
+	lower := []rune(strings.ToLower(string(runes)))
+	matches := make([]match, 0, n)
+
+	// dictionary words, with l33t substitutions and capitalization
+	d := dictionary()
+	for i := 0; i < n; i++ {
+		for j := i + 3; j <= n; j++ {
+			word := string(lower[i:j])
+			plain := leet.Replace(word)
+			rank, found := d[plain]
+			if !found {
+				continue
+			}
+			g := rank * upperVariations(runes[i:j])
+			if plain != word {
+				g *= 2
+			}
+			matches = append(matches, match{i, j, math.Max(g, 10), "распространенный пароль или слово из словаря"})
+		}
+	}
+
+	// repeated characters
+	for i := 0; i < n; {
+		j := i + 1
+		for j < n && runes[j] == runes[i] {
+			j++
+		}
+		if j-i >= 3 {
+			matches = append(matches, match{i, j, cardinality(runes[i]) * float64(j-i), "повторяющиеся символы"})
+		}
+		i = j
+	}
+
+	// sequences like abc, 987
+	for i := 0; i+2 < n; {
+		delta := lower[i+1] - lower[i]
+		if delta != 1 && delta != -1 {
+			i++
+			continue
+		}
+		j := i + 2
+		for j < n && lower[j]-lower[j-1] == delta {
+			j++
+		}
+		if j-i >= 3 {
+			g := cardinality(runes[i]) * float64(j-i)
+			if delta < 0 {
+				g *= 2
+			}
+			matches = append(matches, match{i, j, g, "последовательность символов"})
+			i = j
+			continue
+		}
+		i++
+	}
+
+	// keyboard rows like qwerty or asdf
+	for _, row := range keyboardRows {
+		r := []rune(row)
+		for i := 0; i < n; i++ {
+			for j := i + 3; j <= n; j++ {
+				seq := string(lower[i:j])
+				if !strings.Contains(string(r), seq) && !strings.Contains(string(r), reverse(seq)) {
+					break
+				}
+				matches = append(matches, match{i, j, 20 * float64(j-i), "ряд клавиш на клавиатуре"})
+			}
+		}
+	}
+
+	// years
+	for i := 0; i+4 <= n; i++ {
+		y := string(runes[i : i+4])
+		if (strings.HasPrefix(y, "19") || strings.HasPrefix(y, "20")) && isDigits(y) {
+			matches = append(matches, match{i, i + 4, 120, "год легко угадать"})
+		}
+	}
+
+	return matches
+}
+
+func cardinality(r rune) float64 {
+	switch {
+	case unicode.IsDigit(r):
+		return 10
+	case unicode.IsLower(r), unicode.IsUpper(r):
+		return 26
+	default:
+		return 33
+	}
+}
+
+func upperVariations(word []rune) float64 {
+	upper := 0
+	for _, r := range word {
+		if unicode.IsUpper(r) {
+			upper++
+		}
+	}
+	switch {
+	case upper == 0:
+		return 1
+	case upper == len(word) || (upper == 1 && unicode.IsUpper(word[0])):
+		return 2
+	default:
+		return math.Pow(2, float64(upper))
+	}
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func reverse(s string) string {
+	r := []rune(s)
+	for i, j := 0, len(r)-1; i < j; i, j = i+1, j-1 {
+		r[i], r[j] = r[j], r[i]
+	}
+	return string(r)
+}
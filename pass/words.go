@@ -0,0 +1,60 @@
+package pass
+
+import (
+	"strings"
+	"sync"
+)
+
+// WordOptions ...
+type WordOptions struct {
+	// Words is the number of words in the passphrase
+	Words int
+	// Separator is put between the words
+	Separator string
+	// Capitalize upper cases the first letter of every word
+	Capitalize bool
+	// Digit appends a random digit to one of the words
+	Digit bool
+}
+
+var (
+	wordsOnce sync.Once
+	words     []string
+)
+
+func wordList() []string {
+	wordsOnce.Do(func() {
+		lines := strings.Split(strings.TrimSpace(effWordList), "\n")
+		words = make([]string, 0, len(lines))
+		for _, line := range lines {
+			fields := strings.Fields(line)
+			words = append(words, fields[len(fields)-1])
+		}
+	})
+	return words
+}
+
+// GeneratePassphrase returns a diceware passphrase of random words from
+// the EFF long word list
+func GeneratePassphrase(o WordOptions) []byte {
+	if o.Words < 1 {
+		o.Words = 1
+	}
+
+	wl := wordList()
+	phrase := make([]string, o.Words)
+	for i := range phrase {
+		w := wl[randomInteger(len(wl))]
+		if o.Capitalize {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		}
+		phrase[i] = w
+	}
+
+	if o.Digit {
+		i := randomInteger(len(phrase))
+		phrase[i] += string(numbers[randomInteger(len(numbers))])
+	}
+
+	return []byte(strings.Join(phrase, o.Separator))
+}
@@ -0,0 +1,226 @@
+package secret
+
+import (
+	"bytes"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	yamlSep = "---"
+
+	// HistoryKey is the section old passwords are kept in
+	HistoryKey = "previous-passwords"
+)
+
+var reKey = regexp.MustCompile(`^([A-Za-z0-9_.-]+):(?:\s+(.*))?$`)
+
+// Secret is a password on the first line followed by an optional body.
+// The body is either a list of `key: value` lines mixed with free text
+// or a YAML document starting with `---`
+type Secret struct {
+	password string
+	lines    []string
+	// data is the mapping of a YAML body. Scalars are kept as written, so
+	// numbers like 007 or long ids are not reformatted
+	data *yaml.Node
+}
+
+// New ...
+func New(password string) *Secret {
+	return &Secret{
+		password: password,
+		lines:    []string{},
+	}
+}
+
+// Parse ...
+func Parse(buf []byte) *Secret {
+	lines := strings.Split(strings.TrimSuffix(string(buf), "\n"), "\n")
+	s := &Secret{
+		password: lines[0],
+		lines:    lines[1:],
+	}
+
+	if len(s.lines) > 0 && strings.TrimSpace(s.lines[0]) == yamlSep {
+		doc := &yaml.Node{}
+		err := yaml.Unmarshal([]byte(strings.Join(s.lines[1:], "\n")), doc)
+		if err == nil && len(doc.Content) > 0 && doc.Content[0].Kind == yaml.MappingNode {
+			s.data = doc.Content[0]
+		}
+	}
+
+	return s
+}
+
+// Password ...
+func (s *Secret) Password() string {
+	return s.password
+}
+
+// SetPassword ...
+func (s *Secret) SetPassword(pw string) {
+	s.password = pw
+}
+
+// Body returns everything after the first line
+func (s *Secret) Body() string {
+	return strings.Join(s.lines, "\n")
+}
+
+// Get returns the value of key. Keys are case insensitive
+func (s *Secret) Get(key string) (string, bool) {
+	if s.data != nil {
+		v := s.value(key)
+		if v == nil {
+			return "", false
+		}
+		if v.Kind == yaml.ScalarNode {
+			return v.Value, true
+		}
+		out, err := encodeYAML(v)
+		if err != nil {
+			return "", false
+		}
+		return strings.TrimSuffix(string(out), "\n"), true
+	}
+
+	for _, line := range s.lines {
+		m := reKey.FindStringSubmatch(line)
+		if m == nil || !strings.EqualFold(m[1], key) {
+			continue
+		}
+		return m[2], true
+	}
+	return "", false
+}
+
+// Set replaces the value of key or appends it to the body
+func (s *Secret) Set(key, value string) {
+	if s.data != nil {
+		node := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+		if v := s.value(key); v != nil {
+			*v = *node
+		} else {
+			s.data.Content = append(s.data.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, node)
+		}
+		s.marshalYAML()
+		return
+	}
+
+	for i, line := range s.lines {
+		m := reKey.FindStringSubmatch(line)
+		if m == nil || !strings.EqualFold(m[1], key) {
+			continue
+		}
+		s.lines[i] = m[1] + ": " + value
+		return
+	}
+	s.lines = append(s.lines, key+": "+value)
+}
+
+// AddHistory appends the old password with the date it was replaced to
+// the previous-passwords section
+func (s *Secret) AddHistory(password string, t time.Time) {
+	entry := t.Format("2006-01-02") + " " + password
+
+	if s.data != nil {
+		item := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: entry}
+		v := s.value(HistoryKey)
+		switch {
+		case v == nil:
+			s.data.Content = append(s.data.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: HistoryKey},
+				&yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{item}})
+		case v.Kind == yaml.SequenceNode:
+			v.Content = append(v.Content, item)
+		default:
+			*v = yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{item}}
+		}
+		s.marshalYAML()
+		return
+	}
+
+	item := "  - " + entry
+	for i, line := range s.lines {
+		m := reKey.FindStringSubmatch(line)
+		if m == nil || !strings.EqualFold(m[1], HistoryKey) {
+			continue
+		}
+		j := i + 1
+		for j < len(s.lines) && strings.HasPrefix(s.lines[j], "  - ") {
+			j++
+		}
+		s.lines = append(s.lines[:j], append([]string{item}, s.lines[j:]...)...)
+		return
+	}
+	s.lines = append(s.lines, HistoryKey+":", item)
+}
+
+// Keys returns the sorted keys of the body
+func (s *Secret) Keys() []string {
+	keys := make([]string, 0, len(s.lines))
+	if s.data != nil {
+		for i := 0; i+1 < len(s.data.Content); i += 2 {
+			keys = append(keys, s.data.Content[i].Value)
+		}
+		sort.Strings(keys)
+		return keys
+	}
+
+	for _, line := range s.lines {
+		if m := reKey.FindStringSubmatch(line); m != nil {
+			keys = append(keys, m[1])
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// Bytes ...
+func (s *Secret) Bytes() []byte {
+	buf := &bytes.Buffer{}
+	buf.WriteString(s.password)
+	buf.WriteString("\n")
+	for _, line := range s.lines {
+		buf.WriteString(line)
+		buf.WriteString("\n")
+	}
+	return buf.Bytes()
+}
+
+func (s *Secret) marshalYAML() {
+	out, err := encodeYAML(s.data)
+	if err != nil {
+		return
+	}
+	s.lines = append([]string{yamlSep}, strings.Split(strings.TrimSuffix(string(out), "\n"), "\n")...)
+}
+
+// value returns the value node of key in the YAML body. Keys are case
+// insensitive
+func (s *Secret) value(key string) *yaml.Node {
+	for i := 0; i+1 < len(s.data.Content); i += 2 {
+		if strings.EqualFold(s.data.Content[i].Value, key) {
+			return s.data.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func encodeYAML(node *yaml.Node) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,277 @@
+package storepass
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ebladrocher/keypass/crypto"
+	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/fatih/color"
+)
+
+// Fsck checks the store for problems and fixes those confirmed by
+// fsckFunc. It returns the number of problems left unfixed
+func (s *Store) Fsck() (int, error) {
+	fmt.Printf("Проверка хранилища %s (%s)\n", color.GreenString(s.displayAlias()), s.path)
+
+	problems := 0
+	secrets := make([]string, 0, 10)
+
+	err := filepath.Walk(s.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		rel := strings.TrimPrefix(path, s.path+"/")
+
+		if info.Mode()&os.ModeSymlink != 0 {
+			s.fsckReport("%s является символической ссылкой и игнорируется", rel)
+			problems++
+			return nil
+		}
+
+		if info.IsDir() {
+			if info.Mode().Perm() != dirMode {
+				problems += s.fsckChmod(path, rel, info.Mode().Perm(), dirMode)
+			}
+			return nil
+		}
+
+		hidden := strings.HasPrefix(rel, ".") || strings.Contains(rel, "/.")
+		if !hidden && !strings.HasSuffix(info.Name(), s.crypto.Ext()) {
+			s.fsckReport("посторонний файл %s", rel)
+			if s.fsckConfirm(fmt.Sprintf("Удалить %s?", rel)) {
+				return os.Remove(path)
+			}
+			problems++
+		}
+
+		if info.Mode().Perm() != fileMode {
+			problems += s.fsckChmod(path, rel, info.Mode().Perm(), fileMode)
+		}
+
+		if !hidden && strings.HasSuffix(info.Name(), s.crypto.Ext()) {
+			secrets = append(secrets, strings.TrimSuffix(rel, s.crypto.Ext()))
+		}
+		return nil
+	})
+	if err != nil {
+		return problems, err
+	}
+
+	problems += s.fsckRecipients(secrets)
+	problems += s.fsckKeys()
+
+	n, err := s.fsckGit()
+	if err != nil {
+		return problems, err
+	}
+	problems += n
+
+	return problems, nil
+}
+
+func (s *Store) fsckChmod(path, rel string, have, want os.FileMode) int {
+	s.fsckReport("%s имеет права %04o вместо %04o", rel, have, want)
+	if !s.fsckConfirm(fmt.Sprintf("Исправить права %s?", rel)) {
+		return 1
+	}
+	if err := os.Chmod(path, want); err != nil {
+		fmt.Printf("Не удалось изменить права %s: %s\n", rel, err)
+		return 1
+	}
+	return 0
+}
+
+// fsckRecipients compares the recipients of every secret with its id file
+func (s *Store) fsckRecipients(secrets []string) int {
+	rr, ok := s.crypto.(crypto.RecipientReader)
+	if !ok {
+		fmt.Printf("Проверка получателей не поддерживается бэкендом %s\n", s.crypto.Name())
+		return 0
+	}
+
+	problems := 0
+	for _, name := range secrets {
+		want, err := s.recipientsFor(name)
+		if err != nil {
+			s.fsckReport("не удалось прочитать получателей для %s: %s", name, err)
+			problems++
+			continue
+		}
+		have, err := rr.RecipientsOf(s.passfile(name))
+		if err != nil {
+			s.fsckReport("не удалось определить получателей %s: %s", name, err)
+			problems++
+			continue
+		}
+		if sameRecipients(s.fingerprints(want), have) {
+			continue
+		}
+
+		s.fsckReport("%s зашифрован для %s вместо %s", name, strings.Join(have, ", "), strings.Join(want, ", "))
+		if !s.fsckConfirm(fmt.Sprintf("Перешифровать %s?", name)) {
+			problems++
+			continue
+		}
+		content, err := s.Get(name)
+		if err != nil {
+			fmt.Printf("Не удалось расшифровать %s: %s\n", name, err)
+			problems++
+			continue
+		}
+		if err := s.crypto.Encrypt(s.passfile(name), content, want, s.alwaysTrust); err != nil {
+			fmt.Printf("Не удалось зашифровать %s: %s\n", name, err)
+			problems++
+		}
+	}
+	return problems
+}
+
+// fsckKeys checks that the public keys of all recipients are exported
+func (s *Store) fsckKeys() int {
+	if !s.persistKeys {
+		return 0
+	}
+
+	problems := 0
+	for _, r := range s.allRecipients() {
+		if fsutil.IsFile(filepath.Join(s.path, keyDir, r)) {
+			continue
+		}
+		s.fsckReport("открытый ключ %s не экспортирован в %s", r, keyDir)
+		if !s.fsckConfirm(fmt.Sprintf("Экспортировать ключ %s?", r)) {
+			problems++
+			continue
+		}
+		if err := os.MkdirAll(filepath.Join(s.path, keyDir), dirMode); err != nil {
+			fmt.Printf("Не удалось создать %s: %s\n", keyDir, err)
+			problems++
+			continue
+		}
+		if _, err := s.exportPublicKey(r); err != nil {
+			fmt.Printf("Не удалось экспортировать ключ %s: %s\n", r, err)
+			problems++
+		}
+	}
+	return problems
+}
+
+func (s *Store) fsckGit() (int, error) {
+	changes, err := s.gitChanges()
+	if err == ErrGitNotInit {
+		fmt.Println(color.YellowString("git не инициализирован для этого хранилища"))
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(changes) < 1 {
+		return 0, nil
+	}
+
+	s.fsckReport("%d незафиксированных изменений", len(changes))
+	for _, c := range changes {
+		fmt.Println("   " + c)
+	}
+	if !s.fsckConfirm("Зафиксировать изменения?") {
+		return 1, nil
+	}
+	if err := s.gitAdd(s.path); err != nil {
+		return 1, err
+	}
+	if err := s.gitCommit("Исправления после проверки хранилища."); err != nil {
+		return 1, err
+	}
+	return 0, nil
+}
+
+// allRecipients returns the recipients of every id file in the store
+func (s *Store) allRecipients() []string {
+	m := make(map[string]struct{}, len(s.recipients))
+	for _, fn := range s.idFiles() {
+		rs, err := s.loadRecipients(fn)
+		if err != nil {
+			continue
+		}
+		for _, r := range rs {
+			m[r] = struct{}{}
+		}
+	}
+
+	lst := make([]string, 0, len(m))
+	for r := range m {
+		lst = append(lst, r)
+	}
+	sort.Strings(lst)
+	return lst
+}
+
+// idFiles returns the paths of all id files in the store
+func (s *Store) idFiles() []string {
+	files := make([]string, 0, 1)
+	_ = filepath.Walk(s.path, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() && info.Name() == ".git" {
+			return filepath.SkipDir
+		}
+		if !info.IsDir() && info.Name() == s.crypto.IDFile() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files
+}
+
+// fingerprints resolves the recipients to the fingerprints of their keys
+func (s *Store) fingerprints(recipients []string) []string {
+	fps := make([]string, 0, len(recipients))
+	for _, r := range recipients {
+		if kl, err := s.crypto.ListPublicKeys(r); err == nil && len(kl) > 0 {
+			r = kl[0].Fingerprint
+		}
+		fps = append(fps, r)
+	}
+	return fps
+}
+
+func (s *Store) fsckReport(format string, args ...interface{}) {
+	fmt.Println(color.YellowString(" - "+format, args...))
+}
+
+func (s *Store) fsckConfirm(msg string) bool {
+	if s.fsckFunc == nil {
+		return false
+	}
+	return s.fsckFunc(msg)
+}
+
+func (s *Store) displayAlias() string {
+	if s.alias == "" {
+		return "<root>"
+	}
+	return s.alias
+}
+
+func sameRecipients(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	m := make(map[string]struct{}, len(a))
+	for _, r := range a {
+		m[strings.ToUpper(r)] = struct{}{}
+	}
+	for _, r := range b {
+		if _, found := m[strings.ToUpper(r)]; !found {
+			return false
+		}
+	}
+	return true
+}
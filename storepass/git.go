@@ -7,9 +7,14 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
-	"strings"
+	"time"
 
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ebladrocher/keypass/crypto"
 	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/git"
+	"github.com/ebladrocher/keypass/git/cli"
+	"github.com/ebladrocher/keypass/git/gogit"
 	"github.com/fatih/color"
 )
 
@@ -22,6 +27,13 @@ var (
 	ErrGitNoRemote = fmt.Errorf("git has no remote origin")
 )
 
+const (
+	// GitGoGit is the in-process git backend
+	GitGoGit = "gogit"
+	// GitCLI runs the git binary
+	GitCLI = "cli"
+)
+
 // Git ...
 func (s *Store) Git(args ...string) error {
 	cmd := exec.Command("git", args...)
@@ -43,11 +55,7 @@ func (s *Store) GitInit(signKey string) error {
 		return ErrGitInit
 	}
 
-	cmd := exec.Command("git", "init")
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err := cmd.Run(); err != nil {
+	if err := s.repo.Init(); err != nil {
 		return fmt.Errorf("Не удалось инициализировать git: %s", err)
 	}
 
@@ -58,22 +66,18 @@ func (s *Store) GitInit(signKey string) error {
 		return err
 	}
 
-	if err := ioutil.WriteFile(filepath.Join(s.path, ".gitattributes"), []byte("*.gpg diff=gpg\n"), fileMode); err != nil {
+	attrs := fmt.Sprintf("*%s diff=gpg merge=%s\n", s.crypto.Ext(), mergeDriverName)
+	if err := ioutil.WriteFile(filepath.Join(s.path, ".gitattributes"), []byte(attrs), fileMode); err != nil {
 		return fmt.Errorf("Не удалось инициализировать git: %s", err)
 	}
 	if err := s.gitAdd(s.path + "/.gitattributes"); err != nil {
 		fmt.Println(color.YellowString("Предупреждение: не удалось добавить .gitattributes в git "))
 	}
-	if err := s.gitCommit("Configure git repository for gpg file diff."); err != nil {
+	if err := s.gitCommit("Configure git repository for gpg file diff and merge."); err != nil {
 		fmt.Println(color.YellowString("Предупреждение: не удалось зафиксировать .gitattributes в git"))
 	}
 
-	cmd = exec.Command("git", "config", "--local", "diff.gpg.binary", "true")
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
+	if err := s.GitConfigure(); err != nil {
 		fmt.Printf("Не удалось инициализировать git: %s\n", err)
 	}
 
@@ -84,6 +88,29 @@ func (s *Store) GitInit(signKey string) error {
 	return nil
 }
 
+// GitConfigure sets the local git config for the diff and merge drivers
+// referenced by .gitattributes. It is not shared by git, so every clone
+// needs it
+func (s *Store) GitConfigure() error {
+	if !s.isGit() {
+		return ErrGitNotInit
+	}
+
+	exe := executable()
+	settings := [][]string{
+		{"diff.gpg.binary", "true"},
+		{"diff.gpg.textconv", exe + " " + textconvArgs},
+		{"merge." + mergeDriverName + ".name", "keypass merge driver for encrypted secrets"},
+		{"merge." + mergeDriverName + ".driver", exe + " " + mergeDriverArgs},
+	}
+	for _, kv := range settings {
+		if err := s.repo.ConfigSet(kv[0], kv[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Store) isGit() bool {
 	return fsutil.IsDir(filepath.Join(s.path, ".git"))
 }
@@ -93,32 +120,18 @@ func (s *Store) gitAdd(files ...string) error {
 		return ErrGitNotInit
 	}
 
-	args := []string{"add", "--all"}
-	args = append(args, files...)
-	cmd := exec.Command("git", args...)
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("не удалось добавить файлы в git: %v", err)
-	}
-
-	return nil
+	return s.repo.Add(files...)
 }
 
+// gitCommit commits the staged changes. Having nothing to commit is not
+// an error
 func (s *Store) gitCommit(msg string) error {
 	if !s.isGit() {
 		return ErrGitNotInit
 	}
 
-	cmd := exec.Command("git", "commit", "-m", msg)
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("не удалось добавить файлы в git: %v", err)
+	if err := s.repo.Commit(msg); err != nil && err != git.ErrNothingToCommit {
+		return err
 	}
 
 	return nil
@@ -128,22 +141,15 @@ func (s *Store) gitSetSignKey(sk string) error {
 	if sk == "" {
 		return fmt.Errorf("SignKey не установлен")
 	}
+	if cs, ok := s.repo.(commitSigner); ok && !cs.CanSign() {
+		return fmt.Errorf("этот бэкенд шифрования не может подписывать коммиты без git")
+	}
 
-	cmd := exec.Command("git", "config", "--local", "user.signingkey", sk)
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	if err := cmd.Run(); err != nil {
+	if err := s.repo.ConfigSet("user.signingkey", sk); err != nil {
 		return err
 	}
 
-	cmd = exec.Command("git", "config", "--local", "commit.gpgsign", "true")
-	cmd.Dir = s.path
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-
-	return cmd.Run()
+	return s.repo.ConfigSet("commit.gpgsign", "true")
 }
 
 func (s *Store) gitPush(remote, branch string) error {
@@ -155,7 +161,7 @@ func (s *Store) gitPush(remote, branch string) error {
 		remote = "origin"
 	}
 	if branch == "" {
-		branch = "master"
+		branch = s.gitBranch()
 	}
 
 	if v, err := s.gitConfigValue("remote." + remote + ".url"); err != nil || v == "" {
@@ -163,12 +169,20 @@ func (s *Store) gitPush(remote, branch string) error {
 	}
 
 	if s.autoPull {
-		if err := s.Git("pull", remote, branch); err != nil {
+		if err := s.repo.Pull(remote, branch, false); err != nil {
 			return err
 		}
 	}
 
-	return s.Git("push", remote, branch)
+	return s.repo.Push(remote, branch)
+}
+
+// gitBranch returns the current branch, master if there is none yet
+func (s *Store) gitBranch() string {
+	if b, err := s.repo.Branch(); err == nil && b != "" && b != "HEAD" {
+		return b
+	}
+	return "master"
 }
 
 func (s *Store) gitConfigValue(key string) (string, error) {
@@ -176,16 +190,106 @@ func (s *Store) gitConfigValue(key string) (string, error) {
 		return "", ErrGitNotInit
 	}
 
-	buf := &bytes.Buffer{}
+	return s.repo.ConfigGet(key)
+}
 
-	cmd := exec.Command("git", "config", "--get", key)
-	cmd.Dir = s.path
-	cmd.Stdout = buf
-	cmd.Stderr = os.Stderr
+// gitChanges returns the uncommitted changes in porcelain format
+func (s *Store) gitChanges() ([]string, error) {
+	if !s.isGit() {
+		return nil, ErrGitNotInit
+	}
 
-	if err := cmd.Run(); err != nil {
-		return "", err
+	return s.repo.Status()
+}
+
+// gitLastModified returns the date of the commit that changed the content
+// of the secret to what it is now. Commits that only re-encrypted it, e.g.
+// for new recipients, are skipped
+func (s *Store) gitLastModified(name string) (time.Time, error) {
+	if !s.isGit() {
+		return time.Time{}, ErrGitNotInit
+	}
+
+	commits, err := s.repo.Log(s.passfile(name))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(commits) < 1 {
+		return time.Time{}, fmt.Errorf("%s не зафиксирован в git", name)
+	}
+
+	current, err := s.Get(name)
+	if err != nil {
+		return commits[0].Date, nil
 	}
+	modified := commits[0].Date
+	for _, c := range commits[1:] {
+		content, err := s.GetRevision(name, c.Hash)
+		if err != nil || !bytes.Equal(content, current) {
+			break
+		}
+		modified = c.Date
+	}
+	return modified, nil
+}
 
-	return strings.TrimSpace(buf.String()), nil
+// newGit returns the git backend for the store in path. The in-process
+// backend is the default, the git binary is used if it is chosen and
+// installed. The in-process backend still runs the git binary, if there
+// is one, for merging diverged branches
+func newGit(backend, path string, c crypto.Crypto) git.Git {
+	if !cli.Available() {
+		return gogitFor(path, nil, c)
+	}
+	if backend == GitCLI {
+		return cli.New(path)
+	}
+	return gogitFor(path, cli.New(path), c)
+}
+
+func gogitFor(path string, fallback git.Git, c crypto.Crypto) *gogit.Git {
+	g := gogit.New(path, fallback)
+	if sk, ok := c.(signingKeyProvider); ok {
+		g.SignKey = sk.SigningKey
+	}
+	return g
+}
+
+// signingKeyProvider is implemented by backends that can sign commits made
+// without the git binary
+type signingKeyProvider interface {
+	SigningKey(id string) (*openpgp.Entity, error)
+}
+
+// commitSigner is implemented by git backends that can't always sign
+// commits
+type commitSigner interface {
+	CanSign() bool
+}
+
+// CheckGitBackend returns an error if the git backend can not be used.
+// The in-process backend works without the git binary, but can only
+// fast-forward, so it warns that diverged branches won't be merged
+func CheckGitBackend(backend string) error {
+	switch backend {
+	case "", GitCLI, GitGoGit:
+	default:
+		return fmt.Errorf("неизвестный бэкенд git %s, допустимы '%s' и '%s'", backend, GitCLI, GitGoGit)
+	}
+	if cli.Available() {
+		return nil
+	}
+	if backend == GitCLI {
+		return fmt.Errorf("git не установлен, используйте git: %s", GitGoGit)
+	}
+	fmt.Println(color.YellowString("Предупреждение: git не установлен, разошедшиеся ветки не удастся синхронизировать"))
+	return nil
+}
+
+// GitClone clones repo into path using the given backend
+func GitClone(backend, repo, path string) error {
+	if backend == GitCLI && cli.Available() {
+		return cli.Clone(repo, path)
+	}
+	return gogit.Clone(repo, path)
 }
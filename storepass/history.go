@@ -0,0 +1,96 @@
+package storepass
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"time"
+)
+
+// Revision is a commit that touched a secret
+type Revision struct {
+	Hash    string
+	Author  string
+	Date    time.Time
+	Message string
+}
+
+// History returns the commits that touched the secret, newest first
+func (s *Store) History(name string) ([]Revision, error) {
+	p := s.passfile(name)
+
+	if !strings.HasPrefix(p, s.path) {
+		return nil, ErrSneaky
+	}
+
+	if !s.isGit() {
+		return nil, ErrGitNotInit
+	}
+
+	commits, err := s.repo.Log(p)
+	if err != nil {
+		return nil, err
+	}
+
+	revs := make([]Revision, 0, len(commits))
+	for _, c := range commits {
+		revs = append(revs, Revision(c))
+	}
+
+	if len(revs) < 1 {
+		return nil, ErrNotFound
+	}
+	return revs, nil
+}
+
+// GetRevision decrypts the secret as it was in revision rev
+func (s *Store) GetRevision(name, rev string) ([]byte, error) {
+	p := s.passfile(name)
+
+	if !strings.HasPrefix(p, s.path) {
+		return nil, ErrSneaky
+	}
+
+	if !s.isGit() {
+		return nil, ErrGitNotInit
+	}
+
+	blob, err := s.repo.Show(rev, p)
+	if err != nil {
+		return nil, fmt.Errorf("%s отсутствует в ревизии %s", name, rev)
+	}
+
+	tmp, err := ioutil.TempFile("", "keypass-revision-")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(blob); err != nil {
+		_ = tmp.Close()
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+
+	content, err := s.crypto.Decrypt(tmp.Name())
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return content, nil
+}
+
+// Revert restores the secret from revision rev, encrypted for the
+// current recipients
+func (s *Store) Revert(name, rev string, cb RecipientCallback) error {
+	content, err := s.GetRevision(name, rev)
+	if err != nil {
+		return err
+	}
+
+	return s.SetConfirmMessage(name, content, fmt.Sprintf("Revert %s to %s.", name, rev), cb)
+}
@@ -0,0 +1,104 @@
+package storepass
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ebladrocher/keypass/diff"
+)
+
+const (
+	mergeDriverName = "keypass"
+	mergeDriverArgs = "git merge-driver %O %A %B %P"
+	textconvArgs    = "git textconv"
+)
+
+// executable returns the running binary quoted for the shell git runs the
+// drivers with, so they work without keypass in PATH
+func executable() string {
+	exe, err := os.Executable()
+	if err != nil {
+		return "keypass"
+	}
+	return "'" + strings.Replace(exe, "'", `'\''`, -1) + "'"
+}
+
+// Merge is a git merge driver for encrypted secrets. It decrypts the
+// base, ours and theirs versions, merges the plaintext line by line and
+// writes the result encrypted for the current recipients of name to ours.
+// It reports whether the result contains conflicts
+func (s *Store) Merge(base, ours, theirs, name string) (bool, error) {
+	versions := make([][]string, 0, 3)
+	for _, fn := range []string{base, ours, theirs} {
+		content, err := s.crypto.Decrypt(fn)
+		if err != nil {
+			return false, fmt.Errorf("не удалось расшифровать %s: %s", fn, err)
+		}
+		versions = append(versions, diff.Split(string(content)))
+	}
+
+	merged, conflict := diff.Merge3(versions[0], versions[1], versions[2])
+
+	recipients, err := s.recipientsFor(name)
+	if err != nil {
+		return false, err
+	}
+
+	content := []byte(strings.Join(merged, "\n") + "\n")
+	if err := s.crypto.Encrypt(ours, content, recipients, s.alwaysTrust); err != nil {
+		return false, ErrEncrypt
+	}
+	return conflict, nil
+}
+
+// Merge runs the merge driver in the store whose working tree is dir.
+// path is the name of the conflicting file relative to dir
+func (r *RootStore) Merge(dir, base, ours, theirs, path string) (bool, error) {
+	store, err := r.storeForDir(dir)
+	if err != nil {
+		return false, err
+	}
+
+	name := strings.TrimSuffix(filepath.ToSlash(path), store.crypto.Ext())
+	return store.Merge(base, ours, theirs, name)
+}
+
+// TextConv decrypts file for git diff with the backend of the store whose
+// working tree is dir
+func (r *RootStore) TextConv(dir, file string) ([]byte, error) {
+	store, err := r.storeForDir(dir)
+	if err != nil {
+		store = r.store
+	}
+	content, err := store.crypto.Decrypt(file)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return content, nil
+}
+
+// storeForDir returns the root store or mount located at dir
+func (r *RootStore) storeForDir(dir string) (*Store, error) {
+	dir = realPath(dir)
+	if realPath(r.store.path) == dir {
+		return r.store, nil
+	}
+	for _, sub := range r.mounts {
+		if realPath(sub.path) == dir {
+			return sub, nil
+		}
+	}
+	return nil, fmt.Errorf("%s не является хранилищем паролей", dir)
+}
+
+func realPath(path string) string {
+	if p, err := filepath.Abs(path); err == nil {
+		path = p
+	}
+	if p, err := filepath.EvalSymlinks(path); err == nil {
+		path = p
+	}
+	return filepath.Clean(path)
+}
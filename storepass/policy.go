@@ -0,0 +1,39 @@
+package storepass
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/pass"
+)
+
+// Policy returns the password policy of the nearest policy file for the
+// secret or folder name, walking up to the store root
+func (s *Store) Policy(name string) (pass.Policy, error) {
+	dir := filepath.Join(s.path, name)
+	if !fsutil.IsDir(dir) {
+		dir = filepath.Dir(dir)
+	}
+	for dir == s.path || strings.HasPrefix(dir, s.path+"/") {
+		fn := filepath.Join(dir, pass.PolicyFile)
+		if fsutil.IsFile(fn) {
+			data, err := ioutil.ReadFile(fn)
+			if err != nil {
+				return pass.Policy{}, err
+			}
+			p, err := pass.ParsePolicy(data)
+			if err != nil {
+				return p, fmt.Errorf("%s: %s", fn, err)
+			}
+			return p, nil
+		}
+		if dir == s.path {
+			break
+		}
+		dir = filepath.Dir(dir)
+	}
+	return pass.Policy{}, nil
+}
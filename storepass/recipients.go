@@ -7,11 +7,11 @@ import (
 	"io"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"sort"
 	"strings"
 
-	"github.com/ebladrocher/keypass/crypto/gpg"
 	"github.com/ebladrocher/keypass/fsutil"
 )
 
@@ -22,22 +22,22 @@ const (
 )
 
 // Load ...
-func (s *Store) loadRecipients() ([]string, error) {
-	f, err := os.Open(s.idFile())
+func (s *Store) loadRecipients(idFile string) ([]string, error) {
+	f, err := os.Open(idFile)
 	if err != nil {
 		return []string{}, err
 	}
 
 	defer func() {
 		if err := f.Close(); err != nil {
-			fmt.Printf("Не удалось закрыть %s: %s\n", s.idFile(), err)
+			fmt.Printf("Не удалось закрыть %s: %s\n", idFile, err)
 		}
 	}()
 
 	keys := unmarshalRecipients(f)
 
 	for _, r := range keys {
-		kl, err := gpg.ListPublicKeys(r)
+		kl, err := s.crypto.ListPublicKeys(r)
 		if err != nil {
 			fmt.Printf("Не удалось получить открытый ключ для %s: %s\n", r, err)
 			continue
@@ -86,7 +86,7 @@ func (s *Store) importPublicKey(r string) error {
 		return fmt.Errorf("Открытый ключ %s не найдено в %s", r, filename)
 	}
 
-	return gpg.ImportPublicKey(filename)
+	return s.crypto.ImportPublicKey(filename)
 }
 
 func (s *Store) exportPublicKey(r string) (string, error) {
@@ -95,56 +95,353 @@ func (s *Store) exportPublicKey(r string) (string, error) {
 		return filename, nil
 	}
 
-	if err := gpg.ExportPublicKey(r, filename); err != nil {
+	if err := s.crypto.ExportPublicKey(r, filename); err != nil {
 		return filename, err
 	}
 
 	return filename, nil
 }
 
-func (s *Store) saveRecipients() error {
-	if err := os.MkdirAll(filepath.Dir(s.idFile()), dirMode); err != nil {
+func (s *Store) saveRecipients(msg string) error {
+	paths, err := s.writeRecipients(s.path, s.recipients)
+	if err != nil {
 		return err
 	}
 
-	if err := ioutil.WriteFile(s.idFile(), marshalRecipients(s.recipients), fileMode); err != nil {
-		return err
+	tx := s.Begin()
+	tx.stage(msg, paths...)
+	return tx.Commit(msg)
+}
+
+// writeRecipients writes the id file in dir and, if persistKeys is set,
+// exports the public keys of all recipients. It returns the written files
+func (s *Store) writeRecipients(dir string, recipients []string) ([]string, error) {
+	idFile := s.idFileIn(dir)
+	if err := os.MkdirAll(filepath.Dir(idFile), dirMode); err != nil {
+		return nil, err
 	}
 
+	if err := ioutil.WriteFile(idFile, marshalRecipients(recipients), fileMode); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.exportKeys(recipients)
+	if err != nil {
+		return nil, err
+	}
+	return append([]string{idFile}, keys...), nil
+}
+
+// exportKeys exports the public keys of all recipients if persistKeys is
+// set. It returns the exported files
+func (s *Store) exportKeys(recipients []string) ([]string, error) {
+	paths := make([]string, 0, len(recipients))
 	if !s.persistKeys {
-		return nil
+		return paths, nil
 	}
 
 	if err := os.MkdirAll(filepath.Join(s.path, keyDir), dirMode); err != nil {
-		return err
+		return nil, err
 	}
 
-	for _, r := range s.recipients {
+	for _, r := range recipients {
 		path, err := s.exportPublicKey(r)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		if err := s.gitAdd(path); err != nil {
-			if err == ErrGitNotInit {
-				continue
-			}
-			return err
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// idDir returns the folder of the nearest id file for the secret or
+// folder name, walking up to the store root
+func (s *Store) idDir(name string) string {
+	dir := filepath.Join(s.path, name)
+	if !fsutil.IsDir(dir) {
+		dir = filepath.Dir(dir)
+	}
+	for dir != s.path && strings.HasPrefix(dir, s.path+"/") {
+		if fsutil.IsFile(s.idFileIn(dir)) {
+			return dir
+		}
+		dir = filepath.Dir(dir)
+	}
+	return s.path
+}
+
+// recipientsFor returns the recipients of the nearest id file for name
+func (s *Store) recipientsFor(name string) ([]string, error) {
+	dir := s.idDir(name)
+	if dir == s.path {
+		recipients := make([]string, len(s.recipients))
+		copy(recipients, s.recipients)
+		return recipients, nil
+	}
+	return s.loadRecipients(s.idFileIn(dir))
+}
+
+func (s *Store) resolveRecipients(ids ...string) ([]string, error) {
+	recipients := make([]string, 0, len(ids))
+
+	for _, id := range ids {
+		if id == "" {
+			continue
 		}
-		if err := s.gitCommit(fmt.Sprintf("Exported Public Keys %s", r)); err != nil {
-			return err
+		kl, err := s.crypto.ListPublicKeys(id)
+		if err != nil || len(kl) < 1 {
+			fmt.Println("Не удалось получить открытый ключ:", id)
+			continue
 		}
+		recipients = append(recipients, kl[0].Fingerprint)
 	}
 
-	if s.autoPush {
-		if err := s.gitPush("", ""); err != nil {
-			if err == ErrGitNotInit {
-				return nil
-			}
-			return err
+	if len(recipients) < 1 {
+		return nil, fmt.Errorf("не указаны действительные получатели")
+	}
+
+	kl, err := s.crypto.ListPrivateKeys(recipients...)
+	if err != nil {
+		return nil, fmt.Errorf("Не удалось получить доступные закрытые ключи: %s", err)
+	}
+
+	if len(kl) < 1 {
+		return nil, fmt.Errorf("Ни у одного из получателей нет секретного ключа. Вы не сможете расшифровать добавленные вами секреты")
+	}
+
+	return recipients, nil
+}
+
+// InitFolder creates an id file in folder, so all secrets below it are
+// encrypted for ids instead of the recipients of the parent folders
+func (s *Store) InitFolder(folder string, ids ...string) error {
+	if !s.Initialized() {
+		return fmt.Errorf("хранилище не инициализировано")
+	}
+	dir := filepath.Join(s.path, folder)
+	if dir == s.path || !strings.HasPrefix(dir, s.path+"/") {
+		return ErrSneaky
+	}
+	if fsutil.IsFile(s.idFileIn(dir)) {
+		return fmt.Errorf("папка %s уже инициализирована", folder)
+	}
+	if fsutil.IsFile(s.passfile(folder)) {
+		return fmt.Errorf("%s является секретом", folder)
+	}
+
+	recipients, err := s.resolveRecipients(ids...)
+	if err != nil {
+		return fmt.Errorf("не удалось инициализировать папку: %s", err)
+	}
+
+	return s.updateRecipients(dir, recipients, fmt.Sprintf("Установить получателей для %s.", folder))
+}
+
+// RecipientsFor ...
+func (s *Store) RecipientsFor(name string) []string {
+	recipients, err := s.recipientsFor(name)
+	if err != nil {
+		fmt.Printf("Не удалось прочитать получателей для %s: %s\n", name, err)
+	}
+	return recipients
+}
+
+// AddRecipients adds ids to the nearest id file of folder
+func (s *Store) AddRecipients(folder string, ids ...string) error {
+	recipients, err := s.recipientsFor(folder)
+	if err != nil {
+		return err
+	}
+
+	added := make([]string, 0, len(ids))
+	for _, id := range ids {
+		kl, err := s.crypto.ListPublicKeys(id)
+		if err != nil || len(kl) < 1 {
+			return fmt.Errorf("Не удалось получить открытый ключ: %s", id)
 		}
+		fp := kl[0].Fingerprint
+		if contains(recipients, fp) {
+			return fmt.Errorf("%s уже является получателем", id)
+		}
+		recipients = append(recipients, fp)
+		added = append(added, fp)
+	}
+
+	return s.updateRecipients(s.idDir(folder), recipients, fmt.Sprintf("Добавить получателей %s.", strings.Join(added, ", ")))
+}
+
+// RemoveRecipients removes ids from the nearest id file of folder
+func (s *Store) RemoveRecipients(folder string, ids ...string) error {
+	current, err := s.recipientsFor(folder)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	recipients := make([]string, 0, len(current))
+	removed := make([]string, 0, len(ids))
+	for _, r := range current {
+		if matchRecipient(r, ids) {
+			removed = append(removed, r)
+			continue
+		}
+		recipients = append(recipients, r)
+	}
+
+	if len(removed) != len(ids) {
+		return fmt.Errorf("не все ключи %s являются получателями", strings.Join(ids, ", "))
+	}
+	if len(recipients) < 1 {
+		return fmt.Errorf("нельзя удалить последнего получателя")
+	}
+
+	return s.updateRecipients(s.idDir(folder), recipients, fmt.Sprintf("Удалить получателей %s.", strings.Join(removed, ", ")))
+}
+
+// updateRecipients saves the new recipients of the id file in dir and
+// re-encrypts every secret governed by it. The secrets and the id file are
+// written to hidden temporary files first, which replace the originals
+// once all of them succeeded, the id file last. Everything is recorded in
+// a single commit
+func (s *Store) updateRecipients(dir string, recipients []string, msg string) error {
+	secrets, err := s.decryptAll(dir)
+	if err != nil {
+		return err
+	}
+
+	tmps := make(map[string]string, len(secrets))
+	defer func() {
+		for tmp := range tmps {
+			_ = os.Remove(tmp)
+		}
+	}()
+	for name, content := range secrets {
+		p := s.passfile(name)
+		if !strings.HasPrefix(p, s.path) {
+			return ErrSneaky
+		}
+		tmp := tmpFile(p)
+		_ = os.Remove(tmp)
+		tmps[tmp] = name
+		if err := s.crypto.Encrypt(tmp, content, recipients, s.alwaysTrust); err != nil {
+			return fmt.Errorf("не удалось перешифровать %s: %s", name, err)
+		}
+	}
+
+	idFile := s.idFileIn(dir)
+	idTmp := tmpFile(idFile)
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return err
+	}
+	tmps[idTmp] = ""
+	if err := ioutil.WriteFile(idTmp, marshalRecipients(recipients), fileMode); err != nil {
+		return fmt.Errorf("не удалось сохранить получателей: %s", err)
+	}
+
+	tx := s.Begin()
+	tx.stage(msg, idFile)
+	for tmp, name := range tmps {
+		if tmp == idTmp {
+			continue
+		}
+		p := s.passfile(name)
+		if err := os.Rename(tmp, p); err != nil {
+			return fmt.Errorf("не удалось перешифровать %s: %s", name, err)
+		}
+		delete(tmps, tmp)
+		tx.stage(fmt.Sprintf("Перешифровать %s.", name), p)
+	}
+
+	if err := os.Rename(idTmp, idFile); err != nil {
+		return fmt.Errorf("не удалось сохранить получателей: %s", err)
+	}
+	delete(tmps, idTmp)
+	if dir == s.path {
+		s.recipients = recipients
+	}
+
+	keys, err := s.exportKeys(recipients)
+	if err != nil {
+		return fmt.Errorf("не удалось сохранить получателей: %s", err)
+	}
+	tx.paths = append(tx.paths, keys...)
+
+	return tx.Commit(msg)
+}
+
+// tmpFile returns a hidden temporary name next to file, which the store
+// walker skips
+func tmpFile(file string) string {
+	return filepath.Join(filepath.Dir(file), "."+strings.TrimPrefix(filepath.Base(file), ".")+".tmp")
+}
+
+// decryptAll decrypts every secret below dir that is not governed by a
+// deeper id file, including those in the trash, so nothing is touched if a
+// single secret can not be decrypted
+func (s *Store) decryptAll(dir string) (map[string][]byte, error) {
+	names, err := s.List("")
+	if err != nil {
+		return nil, err
+	}
+	entries, err := s.TrashList()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make(map[string][]byte, len(names)+len(entries))
+	decrypt := func(name, orig string) error {
+		if !s.governs(dir, orig) {
+			return nil
+		}
+		content, err := s.Get(name)
+		if err != nil {
+			return fmt.Errorf("не удалось расшифровать %s: %s", name, err)
+		}
+		secrets[name] = content
+		return nil
+	}
+
+	for _, name := range names {
+		if err := decrypt(name, name); err != nil {
+			return nil, err
+		}
+	}
+	// secrets in the trash belong to the folder they were deleted from
+	for _, e := range entries {
+		if err := decrypt(path.Join(trashDir, e.ID, e.Name), e.Name); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+// governs reports whether the id file in dir applies to the secret name
+func (s *Store) governs(dir, name string) bool {
+	p := filepath.Join(s.path, name)
+	if dir != s.path && !strings.HasPrefix(p, dir+"/") {
+		return false
+	}
+	d := s.idDir(name)
+	return d == dir || strings.HasPrefix(dir, d+"/")
+}
+
+func matchRecipient(r string, ids []string) bool {
+	for _, id := range ids {
+		id = strings.TrimPrefix(id, "0x")
+		if r == id || (len(id) >= 8 && strings.HasSuffix(strings.ToUpper(r), strings.ToUpper(id))) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(haystack []string, needle string) bool {
+	for _, s := range haystack {
+		if s == needle {
+			return true
+		}
+	}
+	return false
 }
 
 func marshalRecipients(r []string) []byte {
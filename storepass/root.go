@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/ebladrocher/keypass/crypto"
 	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/pass"
 	"github.com/ebladrocher/keypass/tree"
 	"github.com/fatih/color"
 )
@@ -21,20 +24,27 @@ type RootStore struct {
 	PersistKeys bool              `json:"persistkeys"`
 	LoadKeys    bool              `json:"loadkeys"`
 	ClipTimeout int               `json:"cliptimeout"`
+	CryptoName  string            `json:"crypto"`
+	GitBackend  string            `json:"git"`
+	Trash       bool              `json:"trash"`
 	Path        string            `json:"path"`
 	Mount       map[string]string `json:"mounts,omitempty"`
 	ImportFunc  ImportCallback    `json:"-"`
 	FsckFunc    FsckCallback      `json:"-"`
+	Crypto      crypto.Crypto     `json:"-"`
+	Backends    []crypto.Crypto   `json:"-"`
 	store       *Store
 	mounts      map[string]*Store
 }
 
 // NewRootStore ...
-func NewRootStore(path string) (*RootStore, error) {
+func NewRootStore(path string, c crypto.Crypto, backends ...crypto.Crypto) (*RootStore, error) {
 	s := &RootStore{
-		Path:   path,
-		Mount:  make(map[string]string),
-		mounts: make(map[string]*Store),
+		Path:     path,
+		Crypto:   c,
+		Backends: backends,
+		Mount:    make(map[string]string),
+		mounts:   make(map[string]*Store),
 	}
 	if err := s.init(); err != nil {
 		return nil, err
@@ -52,8 +62,13 @@ func (r *RootStore) init() error {
 	if r.Path == "" {
 		return fmt.Errorf("Путь не должен быть пустым")
 	}
+	if r.CryptoName != "" {
+		if err := r.useBackend(r.CryptoName); err != nil {
+			return err
+		}
+	}
 
-	s, err := NewStore("", fsutil.CleanPath(r.Path), r)
+	s, err := NewStore("", fsutil.CleanPath(r.Path), r.Crypto, r)
 	if err != nil {
 		return err
 	}
@@ -62,7 +77,7 @@ func (r *RootStore) init() error {
 
 	for alias, path := range r.Mount {
 		path = fsutil.CleanPath(path)
-		if err := r.addMount(alias, path); err != nil {
+		if err := r.addMount(alias, path, ""); err != nil {
 			fmt.Printf("Не удалось инициализировать mount %s (%s): %s. Игнорировать\n", alias, path, err)
 			continue
 		}
@@ -81,17 +96,85 @@ func (r *RootStore) init() error {
 }
 
 // Init tries ...
-func (r *RootStore) Init(store string, ids ...string) error {
+func (r *RootStore) Init(store, backend string, ids ...string) error {
 	sub := r.getStore(store)
+	if sub.Initialized() {
+		return fmt.Errorf("Хранилище уже инициализирован")
+	}
+	if backend != "" {
+		c, err := r.Backend(backend)
+		if err != nil {
+			return err
+		}
+		sub.crypto = c
+		sub.repo = newGit(r.GitBackend, sub.path, c)
+	}
 	sub.persistKeys = r.PersistKeys
 	sub.loadKeys = r.LoadKeys
 	sub.alwaysTrust = r.AlwaysTrust
 	return sub.Init(ids...)
 }
 
-// ListRecipients ...
-func (r *RootStore) ListRecipients(store string) []string {
-	return r.getStore(store).recipients
+// Backend ...
+func (r *RootStore) Backend(name string) (crypto.Crypto, error) {
+	if name == "" || r.Crypto.Name() == name {
+		return r.Crypto, nil
+	}
+	for _, b := range r.Backends {
+		if b.Name() == name {
+			return b, nil
+		}
+	}
+	return nil, fmt.Errorf("неизвестный криптографический бэкенд %s", name)
+}
+
+// useBackend makes the named backend the default one
+func (r *RootStore) useBackend(name string) error {
+	if r.Crypto != nil && r.Crypto.Name() == name {
+		return nil
+	}
+	for i, b := range r.Backends {
+		if b.Name() == name {
+			r.Backends[i], r.Crypto = r.Crypto, b
+			return nil
+		}
+	}
+	return fmt.Errorf("неизвестный криптографический бэкенд %s", name)
+}
+
+// CryptoFor ...
+func (r *RootStore) CryptoFor(name string) crypto.Crypto {
+	return r.getStore(name).crypto
+}
+
+// InitFolder ...
+func (r *RootStore) InitFolder(name string, ids ...string) error {
+	store := r.getStore(name)
+	return store.InitFolder(strings.TrimPrefix(name, store.alias), ids...)
+}
+
+// ListRecipients returns the recipients for the secret or folder name
+func (r *RootStore) ListRecipients(name string) []string {
+	store := r.getStore(name)
+	return store.RecipientsFor(strings.TrimPrefix(name, store.alias))
+}
+
+// AddRecipients ...
+func (r *RootStore) AddRecipients(name string, ids ...string) error {
+	store := r.getStore(name)
+	return store.AddRecipients(strings.TrimPrefix(name, store.alias), ids...)
+}
+
+// RemoveRecipients ...
+func (r *RootStore) RemoveRecipients(name string, ids ...string) error {
+	store := r.getStore(name)
+	return store.RemoveRecipients(strings.TrimPrefix(name, store.alias), ids...)
+}
+
+// Policy ...
+func (r *RootStore) Policy(name string) (pass.Policy, error) {
+	store := r.getStore(name)
+	return store.Policy(strings.TrimPrefix(name, store.alias))
 }
 
 // Initialized ...
@@ -111,6 +194,12 @@ func (r *RootStore) SetConfirm(name string, content []byte, cb RecipientCallback
 	return store.SetConfirm(strings.TrimPrefix(name, store.alias), content, cb)
 }
 
+// SetConfirmMessage ...
+func (r *RootStore) SetConfirmMessage(name string, content []byte, msg string, cb RecipientCallback) error {
+	store := r.getStore(name)
+	return store.SetConfirmMessage(strings.TrimPrefix(name, store.alias), content, msg, cb)
+}
+
 // Get ...
 func (r *RootStore) Get(name string) ([]byte, error) {
 	// forward to substore
@@ -118,39 +207,57 @@ func (r *RootStore) Get(name string) ([]byte, error) {
 	return store.Get(strings.TrimPrefix(name, store.alias))
 }
 
+// History ...
+func (r *RootStore) History(name string) ([]Revision, error) {
+	store := r.getStore(name)
+	return store.History(strings.TrimPrefix(name, store.alias))
+}
+
+// GetRevision ...
+func (r *RootStore) GetRevision(name, rev string) ([]byte, error) {
+	store := r.getStore(name)
+	return store.GetRevision(strings.TrimPrefix(name, store.alias), rev)
+}
+
+// Revert ...
+func (r *RootStore) Revert(name, rev string, cb RecipientCallback) error {
+	store := r.getStore(name)
+	return store.Revert(strings.TrimPrefix(name, store.alias), rev, cb)
+}
+
+// LastModified ...
+func (r *RootStore) LastModified(name string) (time.Time, error) {
+	store := r.getStore(name)
+	return store.LastModified(strings.TrimPrefix(name, store.alias))
+}
+
 // IsDir ...
 func (r *RootStore) IsDir(name string) bool {
 	store := r.getStore(name)
 	return store.IsDir(strings.TrimPrefix(name, store.alias))
 }
 
-// Move ...
+// Move moves a secret or a folder, also from one mount to another. Every
+// store involved gets a single commit
 func (r *RootStore) Move(from, to string) error {
-	subFrom := r.getStore(from)
-	subTo := r.getStore(to)
-
-	// cross-store move
-	if !subFrom.equals(subTo) {
-		content, err := subFrom.Get(from)
-		if err != nil {
-			return err
-		}
-		if err := subTo.Set(to, content); err != nil {
-			return err
-		}
-		if err := subFrom.Delete(from); err != nil {
-			return err
-		}
-		return nil
+	tx := r.Begin()
+	if err := tx.Move(from, to); err != nil {
+		return err
 	}
+	return tx.Commit(fmt.Sprintf("Move %s to %s.", from, to))
+}
 
-	from = strings.TrimPrefix(from, subFrom.alias)
-	to = strings.TrimPrefix(to, subFrom.alias)
-	return subFrom.Move(from, to)
+// Copy copies a secret or a folder, also from one mount to another
+func (r *RootStore) Copy(from, to string) error {
+	tx := r.Begin()
+	if err := tx.Copy(from, to); err != nil {
+		return err
+	}
+	return tx.Commit(fmt.Sprintf("Copy %s to %s.", from, to))
 }
 
 // AddMount ...
-func (r *RootStore) AddMount(alias, path string, keys ...string) error {
+func (r *RootStore) AddMount(alias, path, backend string, keys ...string) error {
 	path = fsutil.CleanPath(path)
 	if r.Mount == nil {
 		r.Mount = make(map[string]string, 1)
@@ -160,17 +267,48 @@ func (r *RootStore) AddMount(alias, path string, keys ...string) error {
 		return fmt.Errorf("%s уже примонтировано", alias)
 	}
 
-	if err := r.addMount(alias, path, keys...); err != nil {
+	if err := r.addMount(alias, path, backend, keys...); err != nil {
 		return err
 	}
 	r.Mount[alias] = path
 
 	if err := r.checkMounts(); err != nil {
+		delete(r.Mount, alias)
+		delete(r.mounts, alias)
 		return err
 	}
 	return nil
 }
 
+// RemoveMount ...
+func (r *RootStore) RemoveMount(alias string) error {
+	if _, found := r.Mount[alias]; !found {
+		return fmt.Errorf("%s не примонтировано", alias)
+	}
+	if _, found := r.mounts[alias]; !found {
+		fmt.Println(color.YellowString("%s не инициализировано, удаление только из конфигурации", alias))
+	}
+	delete(r.Mount, alias)
+	delete(r.mounts, alias)
+	return nil
+}
+
+// Mounts returns the mounted aliases with their paths
+func (r *RootStore) Mounts() map[string]string {
+	m := make(map[string]string, len(r.mounts))
+	for alias, sub := range r.mounts {
+		m[alias] = sub.path
+	}
+	return m
+}
+
+// MountPoints ...
+func (r *RootStore) MountPoints() []string {
+	mps := r.mountPoints()
+	sort.Strings(mps)
+	return mps
+}
+
 // Delete ...
 func (r *RootStore) Delete(name string) error {
 	store := r.getStore(name)
@@ -193,6 +331,91 @@ func (r *RootStore) Prune(tree string) error {
 	return store.Prune(strings.TrimPrefix(tree, store.alias))
 }
 
+// Discard moves a secret or folder to the trash of its store
+func (r *RootStore) Discard(name string, recurse bool) error {
+	if recurse {
+		for mp := range r.mounts {
+			if strings.HasPrefix(mp, name) {
+				return fmt.Errorf("нельзя удалить поддерево с mounts. Сначала отмонтируйте: `keypass mount remove %s`", mp)
+			}
+		}
+	}
+
+	store := r.getStore(name)
+	sn := subName(store, name)
+	if sn == "" {
+		return fmt.Errorf("не возможно удалить точку монтирования. Использовать  `keypass mount remove %s`", store.alias)
+	}
+	return store.Discard(sn, recurse)
+}
+
+// TrashList returns the trash of all stores
+func (r *RootStore) TrashList() ([]TrashEntry, error) {
+	entries, err := r.store.TrashList()
+	if err != nil {
+		return nil, err
+	}
+	for _, alias := range r.MountPoints() {
+		es, err := r.mounts[alias].TrashList()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range es {
+			e.Name = alias + "/" + e.Name
+			entries = append(entries, e)
+		}
+	}
+	return entries, nil
+}
+
+// Restore ...
+func (r *RootStore) Restore(name, id string, force bool) error {
+	store := r.getStore(name)
+	return store.Restore(subName(store, name), id, force)
+}
+
+// EmptyTrash empties the trash of all stores
+func (r *RootStore) EmptyTrash(before time.Time) (int, error) {
+	removed, err := r.store.EmptyTrash(before)
+	if err != nil {
+		return removed, err
+	}
+	for _, alias := range r.MountPoints() {
+		n, err := r.mounts[alias].EmptyTrash(before)
+		removed += n
+		if err != nil {
+			return removed, err
+		}
+	}
+	return removed, nil
+}
+
+// Fsck checks the given store, or every store if store is empty
+func (r *RootStore) Fsck(store string) error {
+	stores := []*Store{r.getStore(store)}
+	if store == "" {
+		stores = []*Store{r.store}
+		for _, alias := range r.MountPoints() {
+			stores = append(stores, r.mounts[alias])
+		}
+	}
+
+	problems := 0
+	for _, sub := range stores {
+		sub.fsckFunc = r.FsckFunc
+		n, err := sub.Fsck()
+		if err != nil {
+			return fmt.Errorf("не удалось проверить %s: %s", sub.path, err)
+		}
+		problems += n
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("осталось неисправленных проблем: %d", problems)
+	}
+	return nil
+}
+
 // String ...
 func (r *RootStore) String() string {
 	ms := make([]string, 0, len(r.mounts))
@@ -207,6 +430,21 @@ func (r *RootStore) GitInit(store, sk string) error {
 	return r.getStore(store).GitInit(sk)
 }
 
+// Sync ...
+func (r *RootStore) Sync(store string) (*SyncResult, error) {
+	return r.getStore(store).Sync()
+}
+
+// GitConfigure ...
+func (r *RootStore) GitConfigure(store string) error {
+	return r.getStore(store).GitConfigure()
+}
+
+// GitChanges ...
+func (r *RootStore) GitChanges(store string) ([]string, error) {
+	return r.getStore(store).gitChanges()
+}
+
 // Git ...
 func (r *RootStore) Git(store string, args ...string) error {
 	return r.getStore(store).Git(args...)
@@ -257,7 +495,7 @@ func (r *RootStore) Tree() (*tree.Folder, error) {
 	return root, nil
 }
 
-func (r *RootStore) addMount(alias, path string, keys ...string) error {
+func (r *RootStore) addMount(alias, path, backend string, keys ...string) error {
 	if r.mounts == nil {
 		r.mounts = make(map[string]*Store, 1)
 	}
@@ -265,7 +503,7 @@ func (r *RootStore) addMount(alias, path string, keys ...string) error {
 		return fmt.Errorf("%s уже примонтировано", alias)
 	}
 
-	s, err := NewStore(alias, fsutil.CleanPath(path), r)
+	s, err := NewStore(alias, fsutil.CleanPath(path), r.Crypto, r)
 	if err != nil {
 		return err
 	}
@@ -274,6 +512,14 @@ func (r *RootStore) addMount(alias, path string, keys ...string) error {
 		if len(keys) < 1 {
 			return fmt.Errorf("password store %s не инициализировано. Попробуйте keypass init", path)
 		}
+		if backend != "" {
+			c, err := r.Backend(backend)
+			if err != nil {
+				return err
+			}
+			s.crypto = c
+			s.repo = newGit(r.GitBackend, s.path, c)
+		}
 		if err := s.Init(keys...); err != nil {
 			return err
 		}
@@ -329,16 +575,17 @@ type rootStore RootStore
 
 // UnmarshalJSON implements a custom JSON unmarshaler
 // that will also make sure the store is properly initialized
-// after loading
+// after loading. The crypto backends set before unmarshaling are kept
 func (r *RootStore) UnmarshalJSON(b []byte) error {
 	s := rootStore{}
 	if err := json.Unmarshal(b, &s); err != nil {
 		return err
 	}
+	c, backends := r.Crypto, r.Backends
 	*r = RootStore(s)
+	r.Crypto, r.Backends = c, backends
 	if err := r.init(); err != nil {
 		return err
 	}
 	return nil
 }
-
@@ -5,14 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
-	"github.com/ebladrocher/keypass/crypto/gpg"
+	"github.com/ebladrocher/keypass/crypto"
 	"github.com/ebladrocher/keypass/fsutil"
-	"github.com/fatih/color"
-)
-
-const (
-	gpgID = ".gpg-id"
+	"github.com/ebladrocher/keypass/git"
 )
 
 var (
@@ -48,31 +45,47 @@ type Store struct {
 	alwaysTrust bool
 	importFunc  ImportCallback
 	fsckFunc    FsckCallback
+	crypto      crypto.Crypto
+	repo        git.Git
 }
 
 // NewStore ...
-func NewStore(alias, path string, r *RootStore) (*Store, error) {
+func NewStore(alias, path string, c crypto.Crypto, r *RootStore) (*Store, error) {
 	if r == nil {
 		r = &RootStore{}
 	}
 	if path == "" {
 		return nil, fmt.Errorf("Нужен путь ")
 	}
+	if c == nil {
+		return nil, fmt.Errorf("Нужен криптографический бэкенд")
+	}
+	if !fsutil.IsFile(filepath.Join(path, c.IDFile())) {
+		for _, b := range r.Backends {
+			if fsutil.IsFile(filepath.Join(path, b.IDFile())) {
+				c = b
+				break
+			}
+		}
+	}
 	s := &Store{
 		autoPush:    r.AutoPush,
 		autoPull:    r.AutoPull,
 		autoImport:  r.AutoImport,
+		persistKeys: r.PersistKeys,
 		loadKeys:    r.LoadKeys,
 		alias:       alias,
 		path:        path,
 		alwaysTrust: r.AlwaysTrust,
 		importFunc:  r.ImportFunc,
 		fsckFunc:    r.FsckFunc,
+		crypto:      c,
+		repo:        newGit(r.GitBackend, path, c),
 		recipients:  make([]string, 0, 5),
 	}
 
 	if fsutil.IsFile(s.idFile()) {
-		keys, err := s.loadRecipients()
+		keys, err := s.loadRecipients(s.idFile())
 		if err != nil {
 			return nil, err
 		}
@@ -92,34 +105,13 @@ func (s *Store) Init(ids ...string) error {
 		return fmt.Errorf("Хранилище уже инициализирован")
 	}
 
-	s.recipients = make([]string, 0, len(ids))
-
-	for _, id := range ids {
-		if id == "" {
-			continue
-		}
-		kl, err := gpg.ListPublicKeys(id)
-		if err != nil || len(kl) < 1 {
-			fmt.Println("Не удалось получить открытый ключ:", id)
-			continue
-		}
-		s.recipients = append(s.recipients, kl[0].Fingerprint)
-	}
-
-	if len(s.recipients) < 1 {
-		return fmt.Errorf("не удалось инициализировать хранилище: не указаны действительные получатели")
-	}
-
-	kl, err := gpg.ListPrivateKeys(s.recipients...)
+	recipients, err := s.resolveRecipients(ids...)
 	if err != nil {
-		return fmt.Errorf("Не удалось получить доступные закрытые ключи: %s", err)
+		return fmt.Errorf("не удалось инициализировать хранилище: %s", err)
 	}
+	s.recipients = recipients
 
-	if len(kl) < 1 {
-		return fmt.Errorf("Ни у одного из получателей нет секретного ключа. Вы не сможете расшифровать добавленные вами секреты")
-	}
-
-	if err := s.saveRecipients(); err != nil {
+	if err := s.saveRecipients("Инициализировать хранилище паролей."); err != nil {
 		return fmt.Errorf("не удалось инициализировать хранилище: %v", err)
 	}
 
@@ -149,7 +141,7 @@ func (s *Store) Get(name string) ([]byte, error) {
 		return []byte{}, ErrNotFound
 	}
 
-	content, err := gpg.Decrypt(p)
+	content, err := s.crypto.Decrypt(p)
 	if err != nil {
 		return []byte{}, ErrDecrypt
 	}
@@ -157,165 +149,52 @@ func (s *Store) Get(name string) ([]byte, error) {
 	return content, nil
 }
 
-// IsDir ...
-func (s *Store) IsDir(name string) bool {
-	return fsutil.IsDir(filepath.Join(s.path, name))
-}
-
-// SetConfirm ...
-func (s *Store) SetConfirm(name string, content []byte, cb RecipientCallback) error {
+// LastModified returns the date the content of the secret last changed
+func (s *Store) LastModified(name string) (time.Time, error) {
 	p := s.passfile(name)
 
 	if !strings.HasPrefix(p, s.path) {
-		return ErrSneaky
-	}
-
-	if s.IsDir(name) {
-		return fmt.Errorf("папка с таким именем %s уже существует", name)
+		return time.Time{}, ErrSneaky
 	}
 
-	recipients := make([]string, len(s.recipients))
-	copy(recipients, s.recipients)
-
-	if cb != nil {
-		newRecipients, err := cb(name, recipients)
-		if err != nil {
-			return err
-		}
-		recipients = newRecipients
-	}
+	return s.gitLastModified(name)
+}
 
-	if err := gpg.Encrypt(p, content, recipients, s.alwaysTrust); err != nil {
-		return ErrEncrypt
-	}
+// IsDir ...
+func (s *Store) IsDir(name string) bool {
+	return fsutil.IsDir(filepath.Join(s.path, name))
+}
 
-	if err := s.gitAdd(p); err != nil {
-		if err == ErrGitNotInit {
-			return nil
-		}
-		return err
-	}
+// SetConfirm ...
+func (s *Store) SetConfirm(name string, content []byte, cb RecipientCallback) error {
+	return s.SetConfirmMessage(name, content, fmt.Sprintf("Сохранить секрет в %s.", name), cb)
+}
 
-	if err := s.gitCommit(fmt.Sprintf("Сохранить секрет в %s.", name)); err != nil {
-		if err == ErrGitNotInit {
-			return nil
-		}
+// SetConfirmMessage is SetConfirm with a custom commit message
+func (s *Store) SetConfirmMessage(name string, content []byte, msg string, cb RecipientCallback) error {
+	tx := s.Begin()
+	if err := tx.SetConfirm(name, content, cb); err != nil {
 		return err
 	}
-
-	if s.autoPush {
-		if err := s.gitPush("", ""); err != nil {
-			if err == ErrGitNotInit {
-				msg := "Warning: git is not initialized for this store. Ignoring auto-push option\n" +
-					"Run: keypass git init"
-				fmt.Println(color.RedString(msg))
-				return nil
-			}
-			if err == ErrGitNoRemote {
-				msg := "Warning: git has not remote. Ignoring auto-push option\n" +
-					"Run: keypass git remote add origin ..."
-				fmt.Println(color.RedString(msg))
-				return nil
-			}
-			return err
-		}
-	}
-
-	return nil
+	return tx.Commit(msg)
 }
 
 // Delete ...
 func (s *Store) Delete(name string) error {
-	return s.delete(name, false)
-}
-
-// Prune ...
-func (s *Store) Prune(tree string) error {
-	return s.delete(tree, true)
-}
-
-func (s *Store) delete(name string, recurse bool) error {
-	path := s.passfile(name)
-	rf := os.Remove
-	if recurse {
-		path = filepath.Join(s.path, name)
-		rf = os.RemoveAll
-	}
-
-	if !recurse && !fsutil.IsFile(path) {
-		return ErrNotFound
-	}
-	if recurse && !fsutil.IsDir(path) {
-		return ErrNotFound
-	}
-
-	if err := rf(path); err != nil {
-		return fmt.Errorf("Не удалось удалить секрет: %v", err)
-	}
-
-	if err := s.gitAdd(path); err != nil {
-		if err == ErrGitNotInit {
-			return nil
-		}
-		return err
-	}
-	if err := s.gitCommit(fmt.Sprintf("Remove %s from store.", name)); err != nil {
-		if err == ErrGitNotInit {
-			return nil
-		}
+	tx := s.Begin()
+	if err := tx.Delete(name); err != nil {
 		return err
 	}
-
-	if s.autoPush {
-		if err := s.gitPush("", ""); err != nil {
-			if err == ErrGitNotInit || err == ErrGitNoRemote {
-				return nil
-			}
-			return err
-		}
-	}
-
-	return nil
+	return tx.Commit(fmt.Sprintf("Remove %s from store.", name))
 }
 
-// Move ...
-func (s *Store) Move(from, to string) error {
-	// recursive move
-	if s.IsDir(from) {
-		if found, err := s.Exists(to); err != nil || found {
-			return fmt.Errorf("Не удается переместить каталог в файл")
-		}
-		sf, err := s.List("")
-		if err != nil {
-			return err
-		}
-		destPrefix := to
-		if s.IsDir(to) {
-			destPrefix = filepath.Join(to, filepath.Base(from))
-		}
-		for _, e := range sf {
-			if !strings.HasPrefix(e, strings.TrimSuffix(from, "/")+"/") {
-				continue
-			}
-			et := filepath.Join(destPrefix, strings.TrimPrefix(e, from))
-			if err := s.Move(e, et); err != nil {
-				fmt.Println(err)
-			}
-		}
-		return nil
-	}
-
-	content, err := s.Get(from)
-	if err != nil {
-		return err
-	}
-	if err := s.Set(to, content); err != nil {
-		return err
-	}
-	if err := s.Delete(from); err != nil {
+// Prune ...
+func (s *Store) Prune(tree string) error {
+	tx := s.Begin()
+	if err := tx.Prune(tree); err != nil {
 		return err
 	}
-	return nil
+	return tx.Commit(fmt.Sprintf("Remove %s from store.", tree))
 }
 
 func (s *Store) equals(other *Store) bool {
@@ -339,7 +218,7 @@ func (s *Store) List(prefix string) ([]string, error) {
 		}
 	}
 
-	if err := filepath.Walk(s.path, mkStoreWalkerFunc(prefix, s.path, addFunc)); err != nil {
+	if err := filepath.Walk(s.path, mkStoreWalkerFunc(prefix, s.path, s.crypto.Ext(), addFunc)); err != nil {
 		return lst, err
 	}
 
@@ -352,14 +231,18 @@ func (s *Store) String() string {
 }
 
 func (s *Store) idFile() string {
-	return fsutil.CleanPath(filepath.Join(s.path, gpgID))
+	return s.idFileIn(s.path)
+}
+
+func (s *Store) idFileIn(dir string) string {
+	return fsutil.CleanPath(filepath.Join(dir, s.crypto.IDFile()))
 }
 
 func (s *Store) passfile(name string) string {
-	return fsutil.CleanPath(filepath.Join(s.path, name) + ".gpg")
+	return fsutil.CleanPath(filepath.Join(s.path, name) + s.crypto.Ext())
 }
 
-func mkStoreWalkerFunc(alias, folder string, fn func(...string)) func(string, os.FileInfo, error) error {
+func mkStoreWalkerFunc(alias, folder, ext string, fn func(...string)) func(string, os.FileInfo, error) error {
 	return func(path string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
@@ -376,14 +259,11 @@ func mkStoreWalkerFunc(alias, folder string, fn func(...string)) func(string, os
 		if path == folder {
 			return nil
 		}
-		if path == filepath.Join(folder, gpgID) {
-			return nil
-		}
 		if info.Mode()&os.ModeSymlink != 0 {
 			return nil
 		}
 		s := strings.TrimPrefix(path, folder+"/")
-		s = strings.TrimSuffix(s, ".gpg")
+		s = strings.TrimSuffix(s, ext)
 		if alias != "" {
 			s = alias + "/" + s
 		}
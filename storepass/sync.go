@@ -0,0 +1,110 @@
+package storepass
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ebladrocher/keypass/git"
+)
+
+// SyncResult summarizes the secrets changed by a sync
+type SyncResult struct {
+	Added    []string
+	Changed  []string
+	Removed  []string
+	Imported []string
+}
+
+// Sync pulls with rebase, pushes and imports the public keys of new
+// recipients
+func (s *Store) Sync() (*SyncResult, error) {
+	if !s.isGit() {
+		return nil, ErrGitNotInit
+	}
+	if v, err := s.gitConfigValue("remote.origin.url"); err != nil || v == "" {
+		return nil, ErrGitNoRemote
+	}
+
+	branch := s.gitBranch()
+	before, _ := s.repo.Head()
+
+	if err := s.repo.Pull("origin", branch, true); err != nil {
+		return nil, err
+	}
+
+	res := &SyncResult{}
+	if before != "" {
+		changes, err := s.repo.Changes(before, "HEAD")
+		if err != nil {
+			return nil, err
+		}
+		s.syncChanges(res, changes)
+	}
+
+	if err := s.repo.Push("origin", branch); err != nil {
+		return res, err
+	}
+
+	res.Imported = s.importKeys()
+
+	return res, nil
+}
+
+func (s *Store) syncChanges(res *SyncResult, changes []git.Change) {
+	for _, c := range changes {
+		name := filepath.ToSlash(c.Path)
+		if !strings.HasSuffix(name, s.crypto.Ext()) || strings.HasPrefix(name, ".") || strings.Contains(name, "/.") {
+			continue
+		}
+		name = strings.TrimSuffix(name, s.crypto.Ext())
+
+		switch c.Op {
+		case 'A':
+			res.Added = append(res.Added, name)
+		case 'D':
+			res.Removed = append(res.Removed, name)
+		default:
+			res.Changed = append(res.Changed, name)
+		}
+	}
+}
+
+// importKeys imports the public keys of all recipients that are missing
+// from the keyring but present in the exported keys
+func (s *Store) importKeys() []string {
+	imported := make([]string, 0, 1)
+	seen := make(map[string]bool, len(s.recipients))
+
+	for _, fn := range s.idFiles() {
+		f, err := os.Open(fn)
+		if err != nil {
+			continue
+		}
+		recipients := unmarshalRecipients(f)
+		_ = f.Close()
+
+		for _, r := range recipients {
+			if seen[r] {
+				continue
+			}
+			seen[r] = true
+
+			// gpg fails to list unknown keys, so errors count as missing
+			if kl, err := s.crypto.ListPublicKeys(r); err == nil && len(kl) > 0 {
+				continue
+			}
+			if s.importFunc != nil && !s.importFunc(r) {
+				continue
+			}
+			if err := s.importPublicKey(r); err != nil {
+				continue
+			}
+			imported = append(imported, r)
+		}
+	}
+
+	sort.Strings(imported)
+	return imported
+}
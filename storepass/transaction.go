@@ -0,0 +1,402 @@
+package storepass
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/pass"
+	"github.com/fatih/color"
+)
+
+// Transaction collects the changes of several operations on a store and
+// records them in a single commit followed by a single push
+type Transaction struct {
+	store   *Store
+	paths   []string
+	summary []string
+}
+
+// Begin starts a transaction. Nothing is committed until Commit
+func (s *Store) Begin() *Transaction {
+	return &Transaction{
+		store:   s,
+		paths:   make([]string, 0, 10),
+		summary: make([]string, 0, 10),
+	}
+}
+
+// Set ...
+func (t *Transaction) Set(name string, content []byte) error {
+	return t.SetConfirm(name, content, nil)
+}
+
+// SetConfirm encrypts the secret for the recipients of its folder, which
+// cb may change
+func (t *Transaction) SetConfirm(name string, content []byte, cb RecipientCallback) error {
+	s := t.store
+	if s.IsDir(name) {
+		return fmt.Errorf("папка с таким именем %s уже существует", name)
+	}
+
+	recipients, err := s.recipientsFor(name)
+	if err != nil {
+		return err
+	}
+
+	if cb != nil {
+		newRecipients, err := cb(name, recipients)
+		if err != nil {
+			return err
+		}
+		recipients = newRecipients
+	}
+
+	return t.encrypt(name, content, recipients, fmt.Sprintf("Сохранить секрет в %s.", name))
+}
+
+// Delete ...
+func (t *Transaction) Delete(name string) error {
+	return t.delete(name, false)
+}
+
+// Prune ...
+func (t *Transaction) Prune(tree string) error {
+	return t.delete(tree, true)
+}
+
+// Commit commits all changes of the transaction. A summary of the changes
+// is appended to msg if there is more than one
+func (t *Transaction) Commit(msg string) error {
+	s := t.store
+	if len(t.paths) < 1 {
+		return nil
+	}
+	if len(t.summary) > 1 {
+		msg += "\n\n- " + strings.Join(t.summary, "\n- ")
+	}
+	paths := t.paths
+	t.paths = t.paths[:0]
+	t.summary = t.summary[:0]
+
+	if err := s.gitAdd(paths...); err != nil {
+		if err == ErrGitNotInit {
+			return nil
+		}
+		return err
+	}
+
+	if err := s.gitCommit(msg); err != nil {
+		if err == ErrGitNotInit {
+			return nil
+		}
+		return err
+	}
+
+	if !s.autoPush {
+		return nil
+	}
+
+	if err := s.gitPush("", ""); err != nil {
+		if err == ErrGitNotInit {
+			msg := "Warning: git is not initialized for this store. Ignoring auto-push option\n" +
+				"Run: keypass git init"
+			fmt.Println(color.RedString(msg))
+			return nil
+		}
+		if err == ErrGitNoRemote {
+			msg := "Warning: git has not remote. Ignoring auto-push option\n" +
+				"Run: keypass git remote add origin ..."
+			fmt.Println(color.RedString(msg))
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (t *Transaction) encrypt(name string, content []byte, recipients []string, line string) error {
+	s := t.store
+	p := s.passfile(name)
+
+	if !strings.HasPrefix(p, s.path) {
+		return ErrSneaky
+	}
+
+	if err := s.crypto.Encrypt(p, content, recipients, s.alwaysTrust); err != nil {
+		return ErrEncrypt
+	}
+
+	t.stage(line, p)
+	return nil
+}
+
+func (t *Transaction) delete(name string, recurse bool) error {
+	s := t.store
+	p := s.passfile(name)
+	rf := os.Remove
+	if recurse {
+		p = filepath.Join(s.path, name)
+		rf = os.RemoveAll
+	}
+
+	if !strings.HasPrefix(p, s.path) {
+		return ErrSneaky
+	}
+	if !recurse && !fsutil.IsFile(p) {
+		return ErrNotFound
+	}
+	if recurse && !fsutil.IsDir(p) {
+		return ErrNotFound
+	}
+
+	if err := rf(p); err != nil {
+		return fmt.Errorf("Не удалось удалить секрет: %v", err)
+	}
+
+	t.stage(fmt.Sprintf("Remove %s from store.", name), p)
+	return nil
+}
+
+// stage records changed files to be committed, line describes the change
+// in the summary
+func (t *Transaction) stage(line string, paths ...string) {
+	t.paths = append(t.paths, paths...)
+	t.summary = append(t.summary, line)
+}
+
+// RootTransaction is a transaction spanning the root store and its
+// mounts. Every store gets its own commit
+type RootTransaction struct {
+	root *RootStore
+	txs  []*Transaction
+}
+
+// Begin starts a transaction. Nothing is committed until Commit
+func (r *RootStore) Begin() *RootTransaction {
+	return &RootTransaction{
+		root: r,
+		txs:  make([]*Transaction, 0, 2),
+	}
+}
+
+// Set ...
+func (t *RootTransaction) Set(name string, content []byte) error {
+	return t.SetConfirm(name, content, nil)
+}
+
+// SetConfirm ...
+func (t *RootTransaction) SetConfirm(name string, content []byte, cb RecipientCallback) error {
+	store := t.root.getStore(name)
+	return t.tx(store).SetConfirm(subName(store, name), content, cb)
+}
+
+// Delete ...
+func (t *RootTransaction) Delete(name string) error {
+	store := t.root.getStore(name)
+	return t.tx(store).Delete(subName(store, name))
+}
+
+// Prune ...
+func (t *RootTransaction) Prune(tree string) error {
+	store := t.root.getStore(tree)
+	return t.tx(store).Prune(subName(store, tree))
+}
+
+// Move moves a secret or a folder with all secrets below it. Secrets are
+// encrypted for the recipients of their destination. The id and policy
+// files of a folder travel with it, see carry
+func (t *RootTransaction) Move(from, to string) error {
+	return t.transfer(from, to, true)
+}
+
+// Copy copies a secret or a folder with all secrets below it
+func (t *RootTransaction) Copy(from, to string) error {
+	return t.transfer(from, to, false)
+}
+
+func (t *RootTransaction) transfer(from, to string, move bool) error {
+	r := t.root
+	from = strings.TrimSuffix(from, "/")
+	to = strings.TrimSuffix(to, "/")
+
+	if !r.IsDir(from) {
+		content, err := r.Get(from)
+		if err != nil {
+			return err
+		}
+		if err := t.Set(to, content); err != nil {
+			return err
+		}
+		if !move {
+			return nil
+		}
+		return t.Delete(from)
+	}
+
+	for _, mp := range r.mountPoints() {
+		if mp == from || strings.HasPrefix(mp, from+"/") {
+			return fmt.Errorf("нельзя перенести точку монтирования %s", mp)
+		}
+	}
+	if found, err := r.Exists(to); err != nil || found {
+		return fmt.Errorf("Не удается переместить каталог в файл")
+	}
+
+	sf, err := r.List()
+	if err != nil {
+		return err
+	}
+	destPrefix := to
+	if r.IsDir(to) {
+		destPrefix = path.Join(to, path.Base(from))
+	}
+
+	// decrypt everything first, so nothing is written if a single secret
+	// can not be transferred
+	names := make([]string, 0, len(sf))
+	secrets := make(map[string][]byte, len(sf))
+	errs := make([]string, 0, len(sf))
+	for _, e := range sf {
+		if !strings.HasPrefix(e, from+"/") {
+			continue
+		}
+		content, err := r.Get(e)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", e, err))
+			continue
+		}
+		names = append(names, e)
+		secrets[e] = content
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("не удалось перенести %s:\n%s", from, strings.Join(errs, "\n"))
+	}
+
+	controls, err := t.carry(from, destPrefix)
+	if err != nil {
+		return fmt.Errorf("не удалось перенести %s: %s", from, err)
+	}
+
+	for _, e := range names {
+		if err := t.Set(path.Join(destPrefix, strings.TrimPrefix(e, from)), secrets[e]); err != nil {
+			return fmt.Errorf("не удалось перенести %s: %s", e, err)
+		}
+		if !move {
+			continue
+		}
+		if err := t.Delete(e); err != nil {
+			return fmt.Errorf("не удалось перенести %s: %s", e, err)
+		}
+	}
+	if !move {
+		return nil
+	}
+
+	src := r.getStore(from)
+	tx := t.tx(src)
+	for _, fn := range controls {
+		if err := os.Remove(fn); err != nil {
+			return fmt.Errorf("не удалось перенести %s: %s", from, err)
+		}
+		tx.stage(fmt.Sprintf("Remove %s from store.", strings.TrimPrefix(fn, src.path+"/")), fn)
+	}
+	removeEmptyDirs(filepath.Join(src.path, subName(src, from)))
+	return nil
+}
+
+// carry copies the id and policy files below the folder from to the same
+// places below to, before the secrets are encrypted there, so a moved or
+// copied folder keeps its recipients and policy. A file already at the
+// destination is kept, and an id file is only carried to a store of the
+// same crypto backend. It returns the id and policy files found below from
+func (t *RootTransaction) carry(from, to string) ([]string, error) {
+	r := t.root
+	src := r.getStore(from)
+	dir := filepath.Join(src.path, subName(src, from))
+
+	found := make([]string, 0, 2)
+	err := filepath.Walk(dir, func(fn string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			if fn != dir && strings.HasPrefix(fi.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if fi.Name() != src.crypto.IDFile() && fi.Name() != pass.PolicyFile {
+			return nil
+		}
+		found = append(found, fn)
+
+		name := path.Join(to, filepath.ToSlash(strings.TrimPrefix(fn, dir+"/")))
+		dst := r.getStore(name)
+		if fi.Name() == src.crypto.IDFile() && dst.crypto.IDFile() != fi.Name() {
+			return nil
+		}
+		dfn := filepath.Join(dst.path, subName(dst, name))
+		if !strings.HasPrefix(dfn, dst.path+"/") {
+			return ErrSneaky
+		}
+		if fsutil.IsFile(dfn) {
+			return nil
+		}
+
+		data, err := ioutil.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(dfn), dirMode); err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(dfn, data, fileMode); err != nil {
+			return err
+		}
+		tx := t.tx(dst)
+		tx.stage(fmt.Sprintf("Перенести %s в %s.", strings.TrimPrefix(fn, src.path+"/"), name), dfn)
+
+		if fi.Name() == dst.crypto.IDFile() && !dst.equals(src) {
+			keys, err := dst.exportKeys(unmarshalRecipients(bytes.NewReader(data)))
+			if err != nil {
+				return err
+			}
+			tx.paths = append(tx.paths, keys...)
+		}
+		return nil
+	})
+	return found, err
+}
+
+// Commit commits the changes of every store touched by the transaction
+func (t *RootTransaction) Commit(msg string) error {
+	for _, tx := range t.txs {
+		if err := tx.Commit(msg); err != nil {
+			return fmt.Errorf("не удалось зафиксировать изменения в %s: %s", tx.store.path, err)
+		}
+	}
+	return nil
+}
+
+func (t *RootTransaction) tx(store *Store) *Transaction {
+	for _, tx := range t.txs {
+		if tx.store.equals(store) {
+			return tx
+		}
+	}
+	tx := store.Begin()
+	t.txs = append(t.txs, tx)
+	return tx
+}
+
+// subName returns name relative to the store it belongs to
+func subName(store *Store, name string) string {
+	return strings.TrimPrefix(strings.TrimPrefix(name, store.alias), "/")
+}
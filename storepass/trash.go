@@ -0,0 +1,307 @@
+package storepass
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ebladrocher/keypass/fsutil"
+	"github.com/ebladrocher/keypass/pass"
+)
+
+const (
+	trashDir    = ".trash"
+	trashLayout = "2006-01-02T15-04-05Z"
+)
+
+// TrashEntry is a secret moved to the trash
+type TrashEntry struct {
+	Name    string
+	ID      string
+	Deleted time.Time
+}
+
+// Discard moves the secret, or the folder if recurse is set, to the
+// trash. Each deletion gets its own folder named after the time of
+// deletion, so deleting a name twice keeps both versions
+func (s *Store) Discard(name string, recurse bool) error {
+	tx := s.Begin()
+	if err := tx.Discard(name, recurse); err != nil {
+		return err
+	}
+	return tx.Commit(fmt.Sprintf("Переместить %s в корзину.", name))
+}
+
+// Discard ...
+func (t *Transaction) Discard(name string, recurse bool) error {
+	s := t.store
+	src := s.passfile(name)
+	if recurse {
+		src = filepath.Join(s.path, name)
+	}
+	if !strings.HasPrefix(src, s.path+"/") {
+		return ErrSneaky
+	}
+	if !recurse && !fsutil.IsFile(src) {
+		return ErrNotFound
+	}
+	if recurse && !fsutil.IsDir(src) {
+		return ErrNotFound
+	}
+
+	id, err := s.newTrashID(time.Now())
+	if err != nil {
+		return err
+	}
+	dst := filepath.Join(s.path, trashDir, id, strings.TrimPrefix(src, s.path+"/"))
+	if err := os.MkdirAll(filepath.Dir(dst), dirMode); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err != nil {
+		return fmt.Errorf("Не удалось переместить секрет в корзину: %v", err)
+	}
+
+	t.stage(fmt.Sprintf("Переместить %s в корзину.", name), src, dst)
+	return nil
+}
+
+// TrashList returns the secrets in the trash, most recently deleted first
+func (s *Store) TrashList() ([]TrashEntry, error) {
+	ids, err := s.trashIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]TrashEntry, 0, len(ids))
+	for _, id := range ids {
+		deleted, err := trashTime(id)
+		if err != nil {
+			continue
+		}
+		dir := filepath.Join(s.path, trashDir, id)
+		err = filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() || strings.HasPrefix(info.Name(), ".") || !strings.HasSuffix(path, s.crypto.Ext()) {
+				return nil
+			}
+			entries = append(entries, TrashEntry{
+				Name:    strings.TrimSuffix(strings.TrimPrefix(path, dir+"/"), s.crypto.Ext()),
+				ID:      id,
+				Deleted: deleted,
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].ID != entries[j].ID {
+			return entries[i].ID > entries[j].ID
+		}
+		return entries[i].Name < entries[j].Name
+	})
+	return entries, nil
+}
+
+// Restore moves the secret or folder name back from the trash. Without
+// an id the most recent deletion is restored
+func (s *Store) Restore(name, id string, force bool) error {
+	name = strings.Trim(name, "/")
+	entries, err := s.TrashList()
+	if err != nil {
+		return err
+	}
+
+	if id == "" {
+		for _, e := range entries {
+			if e.Name == name || strings.HasPrefix(e.Name, name+"/") {
+				id = e.ID
+				break
+			}
+		}
+		if id == "" {
+			return fmt.Errorf("%s нет в корзине", name)
+		}
+	}
+
+	dir := filepath.Join(s.path, trashDir, id)
+	src := filepath.Join(dir, name) + s.crypto.Ext()
+	if !fsutil.IsFile(src) {
+		src = filepath.Join(dir, name)
+	}
+	if !strings.HasPrefix(src, dir+"/") {
+		return ErrSneaky
+	}
+	if !fsutil.IsFile(src) && !fsutil.IsDir(src) {
+		return fmt.Errorf("%s нет в корзине %s", name, id)
+	}
+
+	// restore file by file, the folder may exist again by now. The id and
+	// policy files are moved back as they are, other dot files stay in the
+	// trash
+	moves := make(map[string]string, 10)
+	controls := make(map[string]string, 2)
+	err = filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		base := info.Name()
+		control := base == s.crypto.IDFile() || base == pass.PolicyFile
+		if !control && (strings.HasPrefix(base, ".") || !strings.HasSuffix(base, s.crypto.Ext())) {
+			return nil
+		}
+		dst := filepath.Join(s.path, strings.TrimPrefix(path, dir+"/"))
+		if fsutil.IsFile(dst) && !force {
+			rel := strings.TrimPrefix(dst, s.path+"/")
+			if !control {
+				rel = strings.TrimSuffix(rel, s.crypto.Ext())
+			}
+			return fmt.Errorf("%s уже существует", rel)
+		}
+		if control {
+			controls[path] = dst
+		} else {
+			moves[path] = dst
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the recipients may have changed since the deletion, so the secrets
+	// are encrypted again for the recipients of their folder
+	contents := make(map[string][]byte, len(moves))
+	for from := range moves {
+		content, err := s.crypto.Decrypt(from)
+		if err != nil {
+			return fmt.Errorf("не удалось расшифровать %s: %s", strings.TrimPrefix(from, dir+"/"), err)
+		}
+		contents[from] = content
+	}
+
+	tx := s.Begin()
+	// the id files go first, so the secrets below them get their recipients
+	for from, to := range controls {
+		restored := strings.TrimPrefix(to, s.path+"/")
+		if err := os.MkdirAll(filepath.Dir(to), dirMode); err != nil {
+			return fmt.Errorf("Не удалось восстановить %s: %v", restored, err)
+		}
+		if err := os.Rename(from, to); err != nil {
+			return fmt.Errorf("Не удалось восстановить %s: %v", restored, err)
+		}
+		tx.stage(fmt.Sprintf("Восстановить %s.", restored), from, to)
+	}
+	for from, to := range moves {
+		restored := strings.TrimSuffix(strings.TrimPrefix(to, s.path+"/"), s.crypto.Ext())
+		recipients, err := s.recipientsFor(restored)
+		if err != nil {
+			return err
+		}
+		if err := tx.encrypt(restored, contents[from], recipients, fmt.Sprintf("Восстановить %s.", restored)); err != nil {
+			return fmt.Errorf("Не удалось восстановить %s: %v", restored, err)
+		}
+		if err := os.Remove(from); err != nil {
+			return fmt.Errorf("Не удалось восстановить %s: %v", restored, err)
+		}
+		tx.paths = append(tx.paths, from)
+	}
+	removeEmptyDirs(dir)
+
+	return tx.Commit(fmt.Sprintf("Восстановить %s из корзины.", name))
+}
+
+// EmptyTrash removes everything deleted before the given time. It returns
+// the number of secrets removed
+func (s *Store) EmptyTrash(before time.Time) (int, error) {
+	entries, err := s.TrashList()
+	if err != nil {
+		return 0, err
+	}
+	ids, err := s.trashIDs()
+	if err != nil {
+		return 0, err
+	}
+
+	tx := s.Begin()
+	for _, id := range ids {
+		deleted, err := trashTime(id)
+		if err != nil || !deleted.Before(before) {
+			continue
+		}
+		dir := filepath.Join(s.path, trashDir, id)
+		if err := os.RemoveAll(dir); err != nil {
+			return 0, fmt.Errorf("Не удалось очистить корзину: %v", err)
+		}
+		tx.stage(fmt.Sprintf("Удалить %s.", id), dir)
+	}
+	removeEmptyDirs(filepath.Join(s.path, trashDir))
+
+	removed := 0
+	for _, e := range entries {
+		if e.Deleted.Before(before) {
+			removed++
+		}
+	}
+	return removed, tx.Commit("Очистить корзину.")
+}
+
+// newTrashID returns an unused trash folder name for time t
+func (s *Store) newTrashID(t time.Time) (string, error) {
+	base := t.UTC().Format(trashLayout)
+	id := base
+	for i := 1; fsutil.IsDir(filepath.Join(s.path, trashDir, id)); i++ {
+		if i > 100 {
+			return "", fmt.Errorf("не удалось создать папку в корзине")
+		}
+		id = fmt.Sprintf("%s.%d", base, i)
+	}
+	return id, nil
+}
+
+func (s *Store) trashIDs() ([]string, error) {
+	dir := filepath.Join(s.path, trashDir)
+	if !fsutil.IsDir(dir) {
+		return []string{}, nil
+	}
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(fis))
+	for _, fi := range fis {
+		if fi.IsDir() {
+			ids = append(ids, fi.Name())
+		}
+	}
+	return ids, nil
+}
+
+// trashTime returns the time of deletion encoded in a trash folder name
+func trashTime(id string) (time.Time, error) {
+	return time.Parse(trashLayout, strings.SplitN(id, ".", 2)[0])
+}
+
+// removeEmptyDirs removes dir and all folders below it that are empty
+func removeEmptyDirs(dir string) {
+	fis, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, fi := range fis {
+		if fi.IsDir() {
+			removeEmptyDirs(filepath.Join(dir, fi.Name()))
+		}
+	}
+	if fis, err := ioutil.ReadDir(dir); err == nil && len(fis) == 0 {
+		_ = os.Remove(dir)
+	}
+}